@@ -0,0 +1,122 @@
+package check
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type actorKey struct{}
+
+// WithActor returns a copy of the context which carries the specified actor
+// (e.g. the ID of the user which submitted the validated input).
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor carried by the context, if any.
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// AuditOutcome contains the outcome of a validation function executed
+// during an audited validation run.
+type AuditOutcome struct {
+	Index int    `json:"index"`
+	Valid bool   `json:"valid"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// AuditRecord describes an audited validation run.
+type AuditRecord struct {
+	Time      time.Time      `json:"time"`
+	RuleSet   string         `json:"rule_set,omitempty"`
+	Version   string         `json:"version,omitempty"`
+	Actor     string         `json:"actor,omitempty"`
+	InputHash string         `json:"input_hash"`
+	Snapshot  interface{}    `json:"snapshot,omitempty"`
+	Valid     bool           `json:"valid"`
+	Outcomes  []AuditOutcome `json:"outcomes"`
+}
+
+// AuditSink receives the records of audited validation runs.
+type AuditSink interface {
+	Audit(ctx context.Context, record AuditRecord) error
+}
+
+// AuditSinkFunc is an adapter which allows the use of ordinary functions
+// as audit sinks.
+type AuditSinkFunc func(ctx context.Context, record AuditRecord) error
+
+// Audit calls f(ctx, record).
+func (f AuditSinkFunc) Audit(ctx context.Context, record AuditRecord) error {
+	return f(ctx, record)
+}
+
+// Auditor executes validation functions and sends a record of each
+// validation run to its audit sink.
+type Auditor struct {
+	// Sink receives the audit records.
+	Sink AuditSink
+
+	// RuleSet and Version identify the validated rules.
+	RuleSet string
+	Version string
+
+	// Redact returns a snapshot of the input which is safe to be stored.
+	// If nil, only the hash of the input is recorded.
+	Redact func(input interface{}) interface{}
+}
+
+// Run executes a list of validation functions and checks if any of them fail,
+// recording the outcome of each executed validation function. The record
+// contains the SHA-256 hash of the JSON encoding of the input and the actor
+// carried by the context, if any. Returns the first validation error it
+// encounters or, if the validation succeeds, the error of the audit sink.
+func (a *Auditor) Run(ctx context.Context, input interface{}, vfs ...ValidateFunc) error {
+	record := AuditRecord{
+		Time:      time.Now(),
+		RuleSet:   a.RuleSet,
+		Version:   a.Version,
+		Actor:     ActorFrom(ctx),
+		InputHash: hashInput(input),
+		Valid:     true,
+	}
+	if a.Redact != nil {
+		record.Snapshot = a.Redact(input)
+	}
+
+	var runErr error
+	for i, vf := range vfs {
+		outcome := AuditOutcome{Index: i, Valid: true}
+		if runErr = vf(); runErr != nil {
+			outcome.Valid, outcome.Error = false, toError(runErr)
+			record.Valid = false
+		}
+
+		record.Outcomes = append(record.Outcomes, outcome)
+		if runErr != nil {
+			break
+		}
+	}
+
+	if err := a.Sink.Audit(ctx, record); err != nil && runErr == nil {
+		return err
+	}
+
+	return runErr
+}
+
+func hashInput(input interface{}) string {
+	data, err := json.Marshal(input)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%#v", input))
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,133 @@
+package check
+
+import "strings"
+
+// normalizeCode removes the hyphens and spaces used for grouping the
+// digits of product codes.
+func normalizeCode(s string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(s)
+}
+
+// gtinChecksum reports whether the last digit of s is the valid GS1 check
+// digit of the preceding digits. The digits are weighted alternately by 3
+// and 1, starting from the rightmost data digit.
+func gtinChecksum(s string) bool {
+	if !isDigits(s) {
+		return false
+	}
+
+	var sum int
+	for i := len(s) - 2; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return (10-sum%10)%10 == int(s[len(s)-1]-'0')
+}
+
+// mod11Checksum reports whether the last character of s is the valid
+// modulo 11 check digit of the preceding digits, weighted in descending
+// order. A check digit of 10 is represented by `X`.
+func mod11Checksum(s string) bool {
+	n := len(s)
+	if n < 2 || !isDigits(s[:n-1]) {
+		return false
+	}
+
+	var sum int
+	for i := 0; i < n-1; i++ {
+		sum += int(s[i]-'0') * (n - i)
+	}
+
+	check := (11 - sum%11) % 11
+	switch last := s[n-1]; {
+	case last == 'X' || last == 'x':
+		return check == 10
+	case last >= '0' && last <= '9':
+		return check == int(last-'0')
+	}
+
+	return false
+}
+
+// ISBN checks if the isbn parameter is a valid ISBN-10 or ISBN-13 number,
+// including the check digit. Hyphens and spaces are ignored. The ISBN can
+// be empty if the required parameter is false.
+func ISBN(isbn string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(isbn) {
+			return requiredErr(required, "ISBN cannot be empty")
+		}
+
+		s := normalizeCode(isbn)
+		switch len(s) {
+		case 10:
+			if mod11Checksum(s) {
+				return nil
+			}
+		case 13:
+			if (strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979")) && gtinChecksum(s) {
+				return nil
+			}
+		}
+
+		return newError(CodeISBN, isbn, nil, "invalid ISBN `%s`", isbn)
+	}
+}
+
+// ISSN checks if the issn parameter is a valid ISSN (e.g. `2049-3630`),
+// including the check digit. The ISSN can be empty if the required
+// parameter is false.
+func ISSN(issn string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(issn) {
+			return requiredErr(required, "ISSN cannot be empty")
+		}
+
+		s := normalizeCode(issn)
+		if len(s) != 8 || !mod11Checksum(s) {
+			return newError(CodeISSN, issn, nil, "invalid ISSN `%s`", issn)
+		}
+
+		return nil
+	}
+}
+
+// EAN checks if the ean parameter is a valid EAN-8 or EAN-13 barcode
+// number, including the check digit. Hyphens and spaces are ignored.
+// The EAN can be empty if the required parameter is false.
+func EAN(ean string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(ean) {
+			return requiredErr(required, "EAN cannot be empty")
+		}
+
+		s := normalizeCode(ean)
+		if (len(s) != 8 && len(s) != 13) || !gtinChecksum(s) {
+			return newError(CodeEAN, ean, nil, "invalid EAN `%s`", ean)
+		}
+
+		return nil
+	}
+}
+
+// UPC checks if the upc parameter is a valid UPC-A barcode number,
+// including the check digit. Hyphens and spaces are ignored. The UPC can
+// be empty if the required parameter is false.
+func UPC(upc string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(upc) {
+			return requiredErr(required, "UPC cannot be empty")
+		}
+
+		s := normalizeCode(upc)
+		if len(s) != 12 || !gtinChecksum(s) {
+			return newError(CodeUPC, upc, nil, "invalid UPC `%s`", upc)
+		}
+
+		return nil
+	}
+}
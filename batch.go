@@ -0,0 +1,142 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// BatchResult contains the results of the validation of a batch of items.
+type BatchResult struct {
+	// Total is the number of validated items. It is less than the number
+	// of items if the validation was stopped early (see BatchOptions).
+	Total int
+
+	// Truncated reports whether the validation was stopped before all the
+	// items were validated, or before all the errors were collected.
+	Truncated bool
+
+	// Errors contains the errors of the invalid items, keyed by the index
+	// of the items.
+	Errors map[int]Errors
+
+	err error
+}
+
+// Valid reports whether all the items of the batch are valid.
+func (r *BatchResult) Valid() bool {
+	return r.err == nil && len(r.Errors) == 0
+}
+
+// Invalid returns the indexes of the invalid items, in ascending order.
+func (r *BatchResult) Invalid() []int {
+	idxs := make([]int, 0, len(r.Errors))
+	for idx := range r.Errors {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+
+	return idxs
+}
+
+// Err returns the errors of the invalid items as Errors, in the order of
+// the items, with the index of the items used as a field prefix
+// (e.g. `[2].email`). Returns nil if all the items are valid.
+func (r *BatchResult) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	var errs Errors
+	for _, idx := range r.Invalid() {
+		for _, err := range r.Errors[idx] {
+			errs = append(errs, withField(err, fmt.Sprintf("[%d]", idx)))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// Batch validates the items of the slice or array x. The validation
+// functions returned by perItem for each item are all executed and the
+// errors of the invalid items are collected in the returned result.
+func Batch(x interface{}, perItem func(i int, item interface{}) []ValidateFunc) *BatchResult {
+	return BatchOptions{}.Batch(x, perItem)
+}
+
+// BatchOptions configures the validation of batches of items. The zero
+// value validates all the items and collects all the errors.
+type BatchOptions struct {
+	// FailFast stops the validation at the first invalid item. Only the
+	// first error of the item is collected.
+	FailFast bool
+
+	// MaxErrors stops the validation once the specified number of errors
+	// has been collected. Zero means no limit.
+	MaxErrors int
+}
+
+// Batch validates the items of the slice or array x, like the Batch
+// function, stopping early as configured by the options.
+func (o BatchOptions) Batch(x interface{}, perItem func(i int, item interface{}) []ValidateFunc) *BatchResult {
+	v := reflect.ValueOf(x)
+	if kind := v.Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return &BatchResult{
+			err: newError(CodeInvalid, x, nil, "cannot iterate over `%v`", kind),
+		}
+	}
+
+	r := &BatchResult{Errors: map[int]Errors{}}
+
+	var count int
+	for i := 0; i < v.Len() && !r.Truncated; i++ {
+		r.Total++
+
+		var errs Errors
+		vfs := perItem(i, v.Index(i).Interface())
+		for j, vf := range vfs {
+			err := runCheck(vf, vf)
+			if err == nil {
+				continue
+			}
+
+			errs = append(errs, err)
+			if count++; o.FailFast || (o.MaxErrors > 0 && count >= o.MaxErrors) {
+				r.Truncated = j < len(vfs)-1 || i < v.Len()-1
+				break
+			}
+		}
+		if len(errs) > 0 {
+			r.Errors[i] = errs
+		}
+		if o.FailFast && len(errs) > 0 {
+			break
+		}
+	}
+
+	return r
+}
+
+// Partition validates the items of the slice and splits them into valid
+// and invalid items, preserving their order, so that the valid items can
+// be processed while the invalid ones are handled separately (e.g. sent to
+// a dead-letter queue). The errors of the invalid items are keyed by their
+// index in the items slice.
+func Partition[T any](items []T, perItem func(i int, item T) []ValidateFunc) (valid, invalid []T, result *BatchResult) {
+	result = Batch(items, func(i int, _ interface{}) []ValidateFunc {
+		return perItem(i, items[i])
+	})
+
+	for i, item := range items {
+		if _, ok := result.Errors[i]; ok {
+			invalid = append(invalid, item)
+		} else {
+			valid = append(valid, item)
+		}
+	}
+
+	return valid, invalid, result
+}
@@ -0,0 +1,209 @@
+package benchmarks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/adrg/check"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/go-playground/validator/v10"
+)
+
+const batchSize = 10000
+
+// Record is a representative input with 20 validated fields.
+type Record struct {
+	ID       string  `check:"required,len=12" validate:"required,len=12"`
+	Name     string  `check:"required,max_len=64" validate:"required,max=64"`
+	Username string  `check:"required,min_len=3,max_len=32" validate:"required,min=3,max=32"`
+	Email    string  `check:"required,email" validate:"required,email"`
+	Website  string  `check:"url" validate:"omitempty,url"`
+	Phone    string  `check:"min_len=6,max_len=20" validate:"omitempty,min=6,max=20"`
+	Street   string  `check:"required,max_len=128" validate:"required,max=128"`
+	City     string  `check:"required,max_len=64" validate:"required,max=64"`
+	Zip      string  `check:"required,min_len=3,max_len=10" validate:"required,min=3,max=10"`
+	Country  string  `check:"required,len=2" validate:"required,len=2"`
+	Currency string  `check:"in=EUR|GBP|USD" validate:"oneof=EUR GBP USD"`
+	Role     string  `check:"in=admin|user|guest" validate:"oneof=admin user guest"`
+	Status   string  `check:"in=active|inactive" validate:"oneof=active inactive"`
+	Notes    string  `check:"max_len=512" validate:"max=512"`
+	Age      int     `check:"gte=18,lte=130" validate:"gte=18,lte=130"`
+	Score    int     `check:"gte=0,lte=100" validate:"gte=0,lte=100"`
+	Quantity int     `check:"gt=0" validate:"gt=0"`
+	Rank     int     `check:"gte=1,lte=10" validate:"gte=1,lte=10"`
+	Price    float64 `check:"gt=0" validate:"gt=0"`
+	Discount float64 `check:"gte=0,lte=1" validate:"gte=0,lte=1"`
+}
+
+// Validate validates the record using explicit check validation functions.
+func (r *Record) Validate() error {
+	return check.Run(
+		check.Field("ID", check.Required(r.ID), check.Len(r.ID, 12)),
+		check.Field("Name", check.Required(r.Name), check.MaxLen(r.Name, 64)),
+		check.Field("Username", check.Required(r.Username), check.LenBetween(r.Username, 3, 32)),
+		check.Field("Email", check.Email(r.Email, true)),
+		check.Field("Website", check.URL(r.Website, false)),
+		check.Field("Phone", check.When(r.Phone != "", check.LenBetween(r.Phone, 6, 20))),
+		check.Field("Street", check.Required(r.Street), check.MaxLen(r.Street, 128)),
+		check.Field("City", check.Required(r.City), check.MaxLen(r.City, 64)),
+		check.Field("Zip", check.Required(r.Zip), check.LenBetween(r.Zip, 3, 10)),
+		check.Field("Country", check.Required(r.Country), check.Len(r.Country, 2)),
+		check.Field("Currency", check.InT(r.Currency, "EUR", "GBP", "USD")),
+		check.Field("Role", check.InT(r.Role, "admin", "user", "guest")),
+		check.Field("Status", check.InT(r.Status, "active", "inactive")),
+		check.Field("Notes", check.MaxLen(r.Notes, 512)),
+		check.Field("Age", check.BetweenT(r.Age, 18, 130)),
+		check.Field("Score", check.BetweenT(r.Score, 0, 100)),
+		check.Field("Quantity", check.GtT(r.Quantity, 0)),
+		check.Field("Rank", check.BetweenT(r.Rank, 1, 10)),
+		check.Field("Price", check.GtT(r.Price, 0)),
+		check.Field("Discount", check.BetweenT(r.Discount, 0, 1)),
+	)
+}
+
+// ValidateOzzo validates the record using ozzo-validation.
+func (r *Record) ValidateOzzo() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.ID, validation.Required, validation.Length(12, 12)),
+		validation.Field(&r.Name, validation.Required, validation.Length(0, 64)),
+		validation.Field(&r.Username, validation.Required, validation.Length(3, 32)),
+		validation.Field(&r.Email, validation.Required, is.EmailFormat),
+		validation.Field(&r.Website, is.URL),
+		validation.Field(&r.Phone, validation.Length(6, 20)),
+		validation.Field(&r.Street, validation.Required, validation.Length(0, 128)),
+		validation.Field(&r.City, validation.Required, validation.Length(0, 64)),
+		validation.Field(&r.Zip, validation.Required, validation.Length(3, 10)),
+		validation.Field(&r.Country, validation.Required, validation.Length(2, 2)),
+		validation.Field(&r.Currency, validation.In("EUR", "GBP", "USD")),
+		validation.Field(&r.Role, validation.In("admin", "user", "guest")),
+		validation.Field(&r.Status, validation.In("active", "inactive")),
+		validation.Field(&r.Notes, validation.Length(0, 512)),
+		validation.Field(&r.Age, validation.Min(18), validation.Max(130)),
+		validation.Field(&r.Score, validation.Min(0), validation.Max(100)),
+		validation.Field(&r.Quantity, validation.Min(1)),
+		validation.Field(&r.Rank, validation.Min(1), validation.Max(10)),
+		validation.Field(&r.Price, validation.Min(0.01)),
+		validation.Field(&r.Discount, validation.Min(0.0), validation.Max(1.0)),
+	)
+}
+
+func newRecord(i int) Record {
+	return Record{
+		ID:       fmt.Sprintf("rec-%08d", i),
+		Name:     "James Bond",
+		Username: fmt.Sprintf("agent%03d", i%1000),
+		Email:    fmt.Sprintf("agent%d@example.co.uk", i),
+		Website:  "https://mi6.example.co.uk/agents",
+		Phone:    "+442079460958",
+		Street:   "85 Albert Embankment",
+		City:     "London",
+		Zip:      "SE1 7TP",
+		Country:  "GB",
+		Currency: "GBP",
+		Role:     "user",
+		Status:   "active",
+		Notes:    "Licensed to kill.",
+		Age:      37,
+		Score:    i % 101,
+		Quantity: 1 + i%6,
+		Rank:     1 + i%10,
+		Price:    19.99,
+		Discount: 0.15,
+	}
+}
+
+func newBatch() []Record {
+	records := make([]Record, batchSize)
+	for i := range records {
+		records[i] = newRecord(i)
+	}
+
+	return records
+}
+
+func BenchmarkStruct(b *testing.B) {
+	record := newRecord(7)
+
+	b.Run("check/tags", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := check.Struct(&record); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("check/funcs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := record.Validate(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("validator", func(b *testing.B) {
+		v := validator.New()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if err := v.Struct(&record); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ozzo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := record.ValidateOzzo(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkBatch(b *testing.B) {
+	records := newBatch()
+
+	b.Run("check/tags", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range records {
+				if err := check.Struct(&records[j]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("check/funcs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range records {
+				if err := records[j].Validate(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("validator", func(b *testing.B) {
+		v := validator.New()
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range records {
+				if err := v.Struct(&records[j]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("ozzo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range records {
+				if err := records[j].ValidateOzzo(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package check
+
+import (
+	"fmt"
+	"time"
+)
+
+// Period represents the length of a billing period, expressed as a number
+// of years, months and days.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// Common billing periods.
+var (
+	Daily     = Period{Days: 1}
+	Weekly    = Period{Days: 7}
+	Monthly   = Period{Months: 1}
+	Quarterly = Period{Months: 3}
+	Yearly    = Period{Years: 1}
+)
+
+// String returns the string representation of the period.
+func (p Period) String() string {
+	return fmt.Sprintf("%dy%dm%dd", p.Years, p.Months, p.Days)
+}
+
+// addTo adds the period to t. Unlike time.Time.AddDate, the years and months
+// do not overflow into the next month, but are clamped to the end of the
+// target month (e.g. one month after January 31 is February 28 or 29). The
+// days are added afterwards.
+func (p Period) addTo(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	first := time.Date(year+p.Years, month+time.Month(p.Months), 1, 0, 0, 0, 0, t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	return time.Date(first.Year(), first.Month(), day, hour, min, sec, t.Nanosecond(), t.Location()).
+		AddDate(0, 0, p.Days)
+}
+
+// BillingPeriod checks if the interval between start and end matches one of
+// the allowed billing periods. The end of the interval must be after its
+// start. Periods starting at the end of a month end at the end of the target
+// month, if it has fewer days (e.g. a yearly period starting on February 29,
+// 2024 ends on February 28, 2025).
+func BillingPeriod(start, end time.Time, allowed []Period) ValidateFunc {
+	return func() error {
+		if !end.After(start) {
+			return newError(CodeBillingPeriod, end, map[string]interface{}{
+				"start": start,
+				"end":   end,
+			}, "billing period end `%v` is not after its start `%v`", end, start)
+		}
+
+		for _, period := range allowed {
+			if period.addTo(start).Equal(end) {
+				return nil
+			}
+		}
+
+		return newError(CodeBillingPeriod, end, map[string]interface{}{
+			"start":   start,
+			"end":     end,
+			"allowed": allowed,
+		}, "billing period from `%v` to `%v` not in `%v`", start, end, allowed)
+	}
+}
+
+// AnchorDay checks if day is a valid monthly billing anchor day. Only days
+// between 1 and 28 are allowed, so that the anchor exists in every month.
+func AnchorDay(day int) ValidateFunc {
+	return func() error {
+		if err := Between(day, 1, 28)(); err != nil {
+			return newError(CodeAnchorDay, day, map[string]interface{}{"min": 1, "max": 28},
+				"invalid billing anchor day `%d`", day)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,179 @@
+package check
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// binaryValidator returns a validation function which checks if the s
+// parameter can be decoded by the decode function. If the size parameter
+// is specified, the decoded data must have exactly that many bytes.
+func binaryValidator(s string, required bool, size []int, code, name string, decode func(s string) (int, bool)) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, name+" string cannot be empty")
+		}
+
+		n, ok := decode(s)
+		if !ok {
+			return newError(code, s, nil, "invalid %s string `%s`", name, s)
+		}
+		if len(size) > 0 && n != size[0] {
+			return newError(code, s, map[string]interface{}{"size": size[0]},
+				"%s string `%s` must decode to %d bytes", name, s, size[0])
+		}
+
+		return nil
+	}
+}
+
+// Base64 checks if the s parameter is a padded base64 string, using the
+// standard alphabet (RFC 4648). If the size parameter is specified, the
+// decoded data must have exactly that many bytes. The string can be empty
+// if the required parameter is false.
+func Base64(s string, required bool, size ...int) ValidateFunc {
+	return binaryValidator(s, required, size, CodeBase64, "base64", func(s string) (int, bool) {
+		data, err := base64.StdEncoding.Strict().DecodeString(s)
+		return len(data), err == nil
+	})
+}
+
+// Base64URL checks if the s parameter is a base64 string, using the URL
+// and filename safe alphabet (RFC 4648). Padding is optional. If the size
+// parameter is specified, the decoded data must have exactly that many
+// bytes. The string can be empty if the required parameter is false.
+func Base64URL(s string, required bool, size ...int) ValidateFunc {
+	return binaryValidator(s, required, size, CodeBase64, "base64", func(s string) (int, bool) {
+		enc := base64.RawURLEncoding
+		if strings.HasSuffix(s, "=") {
+			enc = base64.URLEncoding
+		}
+
+		data, err := enc.Strict().DecodeString(s)
+		return len(data), err == nil
+	})
+}
+
+// Hex checks if the s parameter is a hexadecimal string of even length.
+// Both lowercase and uppercase digits are allowed. If the size parameter
+// is specified, the decoded data must have exactly that many bytes. The
+// string can be empty if the required parameter is false.
+func Hex(s string, required bool, size ...int) ValidateFunc {
+	return binaryValidator(s, required, size, CodeHex, "hex", func(s string) (int, bool) {
+		data, err := hex.DecodeString(s)
+		return len(data), err == nil
+	})
+}
+
+// Base58 checks if the s parameter is a base58 string, using the Bitcoin
+// alphabet. If the size parameter is specified, the decoded data must have
+// exactly that many bytes. The string can be empty if the required
+// parameter is false.
+func Base58(s string, required bool, size ...int) ValidateFunc {
+	return binaryValidator(s, required, size, CodeBase58, "base58", func(s string) (int, bool) {
+		if len(size) == 0 {
+			// The decoded length is not checked.
+			return 0, isBase58(s)
+		}
+		return base58DecodedLen(s, size[0])
+	})
+}
+
+func isBase58(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(base58Alphabet, s[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// base58DecodedLen returns the number of bytes encoded by the base58
+// string s, if it is at most size. Otherwise, it returns a larger number.
+// Leading zeros are encoded as `1` characters.
+func base58DecodedLen(s string, size int) (int, bool) {
+	if !isBase58(s) {
+		return 0, false
+	}
+
+	var zeros int
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+
+	// Each character encodes log(58)/log(256) (about 0.73) bytes. Strings
+	// with more characters than required for size bytes decode to more
+	// bytes, so they are not decoded.
+	digits := len(s) - zeros
+	if zeros > size || digits > (size-zeros)*138/100+2 {
+		return size + 1, true
+	}
+
+	// Decode the remaining characters into a big-endian byte slice, filled
+	// from the end. For each character, only the length significant bytes
+	// of the number decoded so far, along with the carry, are updated.
+	data := make([]byte, digits*733/1000+1)
+	var length int
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+
+		var j int
+		for k := len(data) - 1; k >= 0 && (carry != 0 || j < length); k-- {
+			carry += int(data[k]) * 58
+			data[k] = byte(carry)
+			carry >>= 8
+			j++
+		}
+		length = j
+	}
+
+	return zeros + length, true
+}
+
+// hashSizes contains the digest sizes, in bytes, of the hash algorithms
+// supported by the Hash validator.
+var hashSizes = map[string]int{
+	"crc32":     4,
+	"md4":       16,
+	"md5":       16,
+	"ripemd160": 20,
+	"sha1":      20,
+	"sha224":    28,
+	"sha256":    32,
+	"sha384":    48,
+	"sha512":    64,
+	"sha3-224":  28,
+	"sha3-256":  32,
+	"sha3-384":  48,
+	"sha3-512":  64,
+	"blake2b":   64,
+	"blake2s":   32,
+}
+
+// Hash checks if the s parameter is a hex encoded digest of the specified
+// hash algorithm (e.g. `md5`, `sha1`, `sha256`, `sha512`, `sha3-256`). The
+// algorithm name is case-insensitive. The string can be empty if the
+// required parameter is false.
+func Hash(s, algo string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "hash cannot be empty")
+		}
+
+		size, ok := hashSizes[strings.ToLower(algo)]
+		if !ok {
+			return newError(CodeInvalid, s, map[string]interface{}{"algo": algo},
+				"unsupported hash algorithm `%s`", algo)
+		}
+		if _, err := hex.DecodeString(s); err != nil || len(s) != 2*size {
+			return newError(CodeHash, s, map[string]interface{}{"algo": algo},
+				"invalid %s hash `%s`", algo, s)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// BreakerPolicy specifies the behaviour of an open circuit breaker.
+type BreakerPolicy int
+
+// Circuit breaker policies.
+const (
+	// BreakerFail makes the wrapped validation functions fail immediately
+	// while the circuit breaker is open.
+	BreakerFail BreakerPolicy = iota
+
+	// BreakerSkip makes the wrapped validation functions pass without being
+	// executed while the circuit breaker is open.
+	BreakerSkip
+
+	// BreakerWarn makes the wrapped validation functions return a warning
+	// (see Warn) without being executed while the circuit breaker is open.
+	// RunWarnings collects the warning without failing the validation.
+	BreakerWarn
+)
+
+// Breaker is a circuit breaker for validation functions which depend on
+// external services (e.g. network lookups). Validation failures (errors of
+// type *Error) are considered regular results, while any other errors are
+// considered failures of the external service, including timeouts
+// (context.DeadlineExceeded). Cancellations (context.Canceled) are neither,
+// as they are caused by the callers. After Threshold consecutive failures,
+// the breaker opens for the Cooldown period, during which the wrapped
+// validation functions are not executed and their result is decided by the
+// configured Policy. After the cooldown period, a single execution is
+// allowed through as a probe, while the others are still handled by the
+// Policy. The breaker closes if the probe succeeds or reopens if it fails.
+type Breaker struct {
+	Threshold int
+	Cooldown  time.Duration
+	Policy    BreakerPolicy
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	halfOpen  bool
+	probing   bool
+}
+
+// NewBreaker returns a new circuit breaker which opens for the cooldown
+// period after threshold consecutive failures.
+func NewBreaker(threshold int, cooldown time.Duration, policy BreakerPolicy) *Breaker {
+	return &Breaker{
+		Threshold: threshold,
+		Cooldown:  cooldown,
+		Policy:    policy,
+	}
+}
+
+// Wrap returns a context-aware validation function which executes vf
+// through the circuit breaker.
+func (b *Breaker) Wrap(vf ValidateCtxFunc) ValidateCtxFunc {
+	return func(ctx context.Context) error {
+		probe, ok := b.allow()
+		if !ok {
+			markSkipped(ctx)
+
+			switch b.Policy {
+			case BreakerSkip:
+				return nil
+			case BreakerWarn:
+				return asWarning(unavailableError())
+			}
+			return unavailableError()
+		}
+
+		err := vf(ctx)
+		b.record(err, probe, errors.Is(ctx.Err(), context.Canceled))
+		return err
+	}
+}
+
+// allow reports whether an execution is allowed through the breaker and
+// whether the execution is the probe of a half-open breaker.
+func (b *Breaker) allow() (probe, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if time.Now().Before(b.openUntil) {
+		return false, false
+	}
+	if !b.halfOpen {
+		return false, true
+	}
+	if b.probing {
+		return false, false
+	}
+
+	b.probing = true
+	return true, true
+}
+
+// record updates the state of the breaker, based on the result of an
+// execution. The results of the executions cancelled by their callers are
+// ignored.
+func (b *Breaker) record(err error, probe, canceled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if canceled || errors.Is(err, context.Canceled) {
+		// The result says nothing about the service. Leave the state as it
+		// is, so that the next execution becomes the probe, if required.
+		return
+	}
+
+	var checkErr *Error
+	if err == nil || errors.As(err, &checkErr) {
+		if probe || !b.halfOpen {
+			b.failures, b.halfOpen = 0, false
+		}
+		return
+	}
+	if b.halfOpen && !probe {
+		// Executions started before the breaker opened do not affect it.
+		return
+	}
+
+	b.failures++
+	if b.halfOpen || b.failures >= b.Threshold {
+		b.openUntil = time.Now().Add(b.Cooldown)
+		b.failures, b.halfOpen = 0, true
+	}
+}
+
+func unavailableError() *Error {
+	return newError(CodeUnavailable, nil, nil, "validation service unavailable")
+}
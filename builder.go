@@ -0,0 +1,208 @@
+package check
+
+// Builder chains the checks of a single value, in order to avoid repeating
+// the value for each check:
+//
+//	err := check.Value(email).Required().Email().MaxLen(254).Validate()
+//
+// The checks are executed in the order in which they are added, when
+// Validate is called. Format checks (e.g. Email) accept empty values, so
+// that optional values can be validated. Use Required to reject them.
+// Builders are not safe for concurrent use while checks are added.
+type Builder struct {
+	value interface{}
+	field string
+	vfs   []ValidateFunc
+}
+
+// Value returns a builder for checking the value x.
+func Value(x interface{}) *Builder {
+	return &Builder{value: x}
+}
+
+// String returns a builder for checking the string s. The specified field
+// name is set on the returned errors (see Field).
+func String(s, field string) *Builder {
+	return &Builder{value: s, field: field}
+}
+
+// Field sets the field name of the errors returned by Validate.
+func (b *Builder) Field(name string) *Builder {
+	b.field = name
+	return b
+}
+
+// Check adds the specified validation functions to the chain.
+func (b *Builder) Check(vfs ...ValidateFunc) *Builder {
+	b.vfs = append(b.vfs, vfs...)
+	return b
+}
+
+// Validate executes the checks of the builder. Returns the first error it
+// encounters. Validate can be used as a ValidateFunc (e.g. passed to Run).
+func (b *Builder) Validate() error {
+	if b.field == "" {
+		return Run(b.vfs...)
+	}
+
+	return Field(b.field, b.vfs...)()
+}
+
+// str adds a check of the value as a string. If the value is not a string,
+// the check fails.
+func (b *Builder) str(fn func(s string) ValidateFunc) *Builder {
+	s, err := toString(b.value)
+	if err != nil {
+		return b.Check(func() error { return err })
+	}
+
+	return b.Check(fn(s))
+}
+
+// Required checks that the value is not empty (see Required).
+func (b *Builder) Required() *Builder {
+	return b.Check(Required(b.value))
+}
+
+// Eq checks that the value is equal to term (see Eq).
+func (b *Builder) Eq(term interface{}) *Builder {
+	return b.Check(Eq(b.value, term))
+}
+
+// Ne checks that the value is not equal to term (see Ne).
+func (b *Builder) Ne(term interface{}) *Builder {
+	return b.Check(Ne(b.value, term))
+}
+
+// Lt checks that the value is less than term (see Lt).
+func (b *Builder) Lt(term interface{}) *Builder {
+	return b.Check(Lt(b.value, term))
+}
+
+// Lte checks that the value is less than or equal to term (see Lte).
+func (b *Builder) Lte(term interface{}) *Builder {
+	return b.Check(Lte(b.value, term))
+}
+
+// Gt checks that the value is greater than term (see Gt).
+func (b *Builder) Gt(term interface{}) *Builder {
+	return b.Check(Gt(b.value, term))
+}
+
+// Gte checks that the value is greater than or equal to term (see Gte).
+func (b *Builder) Gte(term interface{}) *Builder {
+	return b.Check(Gte(b.value, term))
+}
+
+// Between checks that the value is between lower and upper, inclusive
+// (see Between).
+func (b *Builder) Between(lower, upper interface{}) *Builder {
+	return b.Check(Between(b.value, lower, upper))
+}
+
+// In checks that the value is one of the specified elements (see In).
+func (b *Builder) In(elems ...interface{}) *Builder {
+	return b.Check(In(b.value, elems...))
+}
+
+// NotIn checks that the value is none of the specified elements
+// (see NotIn).
+func (b *Builder) NotIn(elems ...interface{}) *Builder {
+	return b.Check(NotIn(b.value, elems...))
+}
+
+// Len checks that the length of the value is n (see Len).
+func (b *Builder) Len(n int) *Builder {
+	return b.Check(Len(b.value, n))
+}
+
+// MinLen checks that the length of the value is at least n (see MinLen).
+func (b *Builder) MinLen(n int) *Builder {
+	return b.Check(MinLen(b.value, n))
+}
+
+// MaxLen checks that the length of the value is at most n (see MaxLen).
+func (b *Builder) MaxLen(n int) *Builder {
+	return b.Check(MaxLen(b.value, n))
+}
+
+// LenBetween checks that the length of the value is between lower and
+// upper, inclusive (see LenBetween).
+func (b *Builder) LenBetween(lower, upper int) *Builder {
+	return b.Check(LenBetween(b.value, lower, upper))
+}
+
+// Matches checks that the value matches the pattern (see Matches).
+func (b *Builder) Matches(pattern string) *Builder {
+	return b.str(func(s string) ValidateFunc { return Matches(s, pattern, false) })
+}
+
+// MatchesNamed checks that the value matches the pattern registered under
+// the specified name (see MatchesNamed).
+func (b *Builder) MatchesNamed(name string) *Builder {
+	return b.str(func(s string) ValidateFunc { return MatchesNamed(s, name, false) })
+}
+
+// HasPrefix checks that the value starts with prefix (see HasPrefix).
+func (b *Builder) HasPrefix(prefix string) *Builder {
+	return b.str(func(s string) ValidateFunc { return HasPrefix(s, prefix) })
+}
+
+// HasSuffix checks that the value ends with suffix (see HasSuffix).
+func (b *Builder) HasSuffix(suffix string) *Builder {
+	return b.str(func(s string) ValidateFunc { return HasSuffix(s, suffix) })
+}
+
+// Contains checks that the value contains substr (see Contains).
+func (b *Builder) Contains(substr string) *Builder {
+	return b.str(func(s string) ValidateFunc { return Contains(s, substr) })
+}
+
+// NotContains checks that the value does not contain substr
+// (see NotContains).
+func (b *Builder) NotContains(substr string) *Builder {
+	return b.str(func(s string) ValidateFunc { return NotContains(s, substr) })
+}
+
+// Email checks that the value is a valid email address (see Email).
+func (b *Builder) Email() *Builder {
+	return b.str(func(s string) ValidateFunc { return Email(s, false) })
+}
+
+// URL checks that the value is a valid URL (see URL).
+func (b *Builder) URL() *Builder {
+	return b.str(func(s string) ValidateFunc { return URL(s, false) })
+}
+
+// UUID checks that the value is a valid UUID (see UUID).
+func (b *Builder) UUID() *Builder {
+	return b.str(func(s string) ValidateFunc { return UUID(s, false) })
+}
+
+// Alpha checks that the value contains only ASCII letters (see Alpha).
+func (b *Builder) Alpha() *Builder {
+	return b.str(func(s string) ValidateFunc { return Alpha(s, false) })
+}
+
+// Alphanumeric checks that the value contains only ASCII letters and
+// digits (see Alphanumeric).
+func (b *Builder) Alphanumeric() *Builder {
+	return b.str(func(s string) ValidateFunc { return Alphanumeric(s, false) })
+}
+
+// Numeric checks that the value contains only ASCII digits (see Numeric).
+func (b *Builder) Numeric() *Builder {
+	return b.str(func(s string) ValidateFunc { return Numeric(s, false) })
+}
+
+// Lowercase checks that the value contains no uppercase letters
+// (see Lowercase).
+func (b *Builder) Lowercase() *Builder {
+	return b.str(func(s string) ValidateFunc { return Lowercase(s, false) })
+}
+
+// Uppercase checks that the value contains no lowercase letters
+// (see Uppercase).
+func (b *Builder) Uppercase() *Builder {
+	return b.str(func(s string) ValidateFunc { return Uppercase(s, false) })
+}
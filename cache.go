@@ -0,0 +1,193 @@
+package check
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Cache stores the encoded results of validation functions. Implementations
+// must be safe for concurrent use. External stores (e.g. Redis, memcached)
+// can be used in order to share results between multiple instances.
+type Cache interface {
+	// Get returns the value stored for key. The returned boolean reports
+	// whether the key was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores the value for key. The value expires after ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Cached returns a context-aware validation function which caches the
+// results of vf in c, for the duration of ttl. The cache key is derived from
+// the name of the validator and the input, as is. Callers should normalize
+// the input (e.g. remove spaces and convert it to uppercase), so that
+// equivalent inputs share the same results. Only successful results and
+// validation failures (errors of type *Error) are cached. Any other errors,
+// warnings (see Warn) and errors with the CodeUnavailable code, such as the
+// ones returned by open circuit breakers, are returned without being cached.
+// The results of executions skipped by open circuit breakers (see
+// BreakerSkip) are not cached either. Errors returned by the cache are
+// ignored and cause vf to be executed.
+//
+// Failures are cached in JSON format, which means that the errors returned
+// from the cache do not have an underlying error (Err is nil), so errors.Is
+// and errors.As cannot match the errors wrapped by the original failure.
+// Also, the validated value (Value) is written to the cache. Use CacheOptions
+// in order to leave it out.
+func Cached(c Cache, name, input string, ttl time.Duration, vf ValidateCtxFunc) ValidateCtxFunc {
+	return CacheOptions{}.Cached(c, name, input, ttl, vf)
+}
+
+// CacheOptions configures how the results of validation functions are cached.
+type CacheOptions struct {
+	// OmitValue leaves the validated value out of the cached failures, so
+	// that it is not written to the cache. The errors returned from the
+	// cache have a nil Value. The messages and parameters of the failures
+	// are cached as they are.
+	OmitValue bool
+}
+
+// cachedError is the cached representation of a validation failure.
+type cachedError struct {
+	Code     string                 `json:"code"`
+	Field    string                 `json:"field,omitempty"`
+	Value    interface{}            `json:"value,omitempty"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Message  string                 `json:"message"`
+	Severity Severity               `json:"severity,omitempty"`
+}
+
+// skippedKey is the context key of the flag set by the circuit breakers
+// which skip the execution of the wrapped validation functions.
+type skippedKey struct{}
+
+// markSkipped records in ctx that the execution of a validation function
+// was skipped, if ctx is used by a caching validation function.
+func markSkipped(ctx context.Context) {
+	if skipped, ok := ctx.Value(skippedKey{}).(*bool); ok {
+		*skipped = true
+	}
+}
+
+// Cached returns a context-aware validation function which caches the
+// results of vf in c, for the duration of ttl (see Cached).
+func (o CacheOptions) Cached(c Cache, name, input string, ttl time.Duration, vf ValidateCtxFunc) ValidateCtxFunc {
+	return func(ctx context.Context) error {
+		key := cacheKey(name, input)
+		if data, ok, err := c.Get(ctx, key); err == nil && ok {
+			if len(data) == 0 {
+				return nil
+			}
+
+			var ce cachedError
+			if err := json.Unmarshal(data, &ce); err == nil {
+				return &Error{
+					Code:     ce.Code,
+					Field:    ce.Field,
+					Value:    ce.Value,
+					Params:   ce.Params,
+					Message:  ce.Message,
+					Severity: ce.Severity,
+				}
+			}
+		}
+
+		var skipped bool
+		err := vf(context.WithValue(ctx, skippedKey{}, &skipped))
+		if skipped {
+			return err
+		}
+
+		var data []byte
+		if err != nil {
+			var checkErr *Error
+			if !errors.As(err, &checkErr) || checkErr.Code == CodeUnavailable || IsWarning(err) {
+				return err
+			}
+
+			ce := cachedError{
+				Code:     checkErr.Code,
+				Field:    checkErr.Field,
+				Params:   checkErr.Params,
+				Message:  checkErr.Message,
+				Severity: checkErr.Severity,
+			}
+			if !o.OmitValue {
+				ce.Value = checkErr.Value
+			}
+			if data, err = json.Marshal(ce); err != nil {
+				return checkErr
+			}
+			err = checkErr
+		}
+
+		_ = c.Set(ctx, key, data, ttl)
+		return err
+	}
+}
+
+func cacheKey(name, input string) string {
+	sum := sha256.Sum256([]byte(input))
+	return "check:" + name + ":" + hex.EncodeToString(sum[:])
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache implementation.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	sweepAt int
+}
+
+// NewMemoryCache returns a new in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// Get returns the value stored for key, if it has not expired.
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+
+	return entry.value, true, nil
+}
+
+// Set stores the value for key. The value expires after ttl.
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Remove the expired entries each time the size of the cache doubles.
+	now := time.Now()
+	if len(c.entries) >= c.sweepAt {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.sweepAt = 2*len(c.entries) + 1
+	}
+	c.entries[key] = cacheEntry{value: value, expires: now.Add(ttl)}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CardBrand represents a payment card brand.
+type CardBrand string
+
+// Payment card brands.
+const (
+	Visa            CardBrand = "visa"
+	MasterCard      CardBrand = "mastercard"
+	AmericanExpress CardBrand = "amex"
+	Discover        CardBrand = "discover"
+	DinersClub      CardBrand = "diners"
+	JCB             CardBrand = "jcb"
+	UnionPay        CardBrand = "unionpay"
+	UnknownBrand    CardBrand = "unknown"
+)
+
+type cardRange struct {
+	brand      CardBrand
+	prefixLen  int
+	start, end int
+	lengths    []int
+}
+
+// The ranges are checked in order, so more specific prefixes must be
+// placed before the more general ones.
+var cardRanges = []cardRange{
+	{Visa, 1, 4, 4, []int{13, 16, 19}},
+	{MasterCard, 2, 51, 55, []int{16}},
+	{MasterCard, 4, 2221, 2720, []int{16}},
+	{AmericanExpress, 2, 34, 34, []int{15}},
+	{AmericanExpress, 2, 37, 37, []int{15}},
+	{Discover, 4, 6011, 6011, []int{16, 17, 18, 19}},
+	{Discover, 3, 644, 649, []int{16, 17, 18, 19}},
+	{Discover, 2, 65, 65, []int{16, 17, 18, 19}},
+	{UnionPay, 2, 62, 62, []int{16, 17, 18, 19}},
+	{DinersClub, 3, 300, 305, []int{14, 15, 16, 17, 18, 19}},
+	{DinersClub, 2, 36, 36, []int{14, 15, 16, 17, 18, 19}},
+	{DinersClub, 2, 38, 39, []int{16, 17, 18, 19}},
+	{JCB, 4, 3528, 3589, []int{16, 17, 18, 19}},
+}
+
+// CreditCard checks if the number parameter is a valid payment card number.
+// Spaces and dashes are ignored. The number must pass the Luhn checksum and,
+// if any brands are specified, it must belong to one of them. The detected
+// brand is included in the parameters of the returned error, under the
+// `brand` key. The number is masked in the returned error, leaving only its
+// last 4 digits visible. The number can be empty if the required parameter
+// is false.
+func CreditCard(number string, required bool, brands ...CardBrand) ValidateFunc {
+	return func() error {
+		if isEmptyStr(number) {
+			return requiredErr(required, "card number cannot be empty")
+		}
+
+		digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+
+		brand := cardBrand(digits)
+		if len(digits) < 12 || len(digits) > 19 || !luhn(digits) {
+			masked := maskCard(digits)
+			return newError(CodeCreditCard, masked, map[string]interface{}{"brand": brand},
+				"invalid card number `%s`", masked)
+		}
+		if len(brands) == 0 {
+			return nil
+		}
+		for _, b := range brands {
+			if b == brand {
+				return nil
+			}
+		}
+
+		return newError(CodeCreditCard, maskCard(digits), map[string]interface{}{
+			"brand":  brand,
+			"brands": brands,
+		}, "card brand `%s` not in `%v`", brand, brands)
+	}
+}
+
+func cardBrand(digits string) CardBrand {
+	for _, r := range cardRanges {
+		if len(digits) < r.prefixLen {
+			continue
+		}
+
+		prefix, err := strconv.Atoi(digits[:r.prefixLen])
+		if err != nil || prefix < r.start || prefix > r.end {
+			continue
+		}
+		for _, l := range r.lengths {
+			if l == len(digits) {
+				return r.brand
+			}
+		}
+	}
+
+	return UnknownBrand
+}
+
+// maskCard replaces all but the last 4 characters of a card number with
+// asterisks. Numbers with at most 4 characters are masked entirely.
+func maskCard(digits string) string {
+	runes := []rune(digits)
+
+	visible := 4
+	if len(runes) <= visible {
+		visible = 0
+	}
+	for i := 0; i < len(runes)-visible; i++ {
+		runes[i] = '*'
+	}
+
+	return string(runes)
+}
+
+func luhn(digits string) bool {
+	var sum int
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
@@ -0,0 +1,213 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+var regPlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// Message is a localized message template. Templates reference the
+// parameters of an error by name (e.g. `{min}`). The `{field}` and `{value}`
+// placeholders are replaced by the field name and the validated value.
+// Placeholders which cannot be resolved are left unchanged.
+type Message struct {
+	// Plural is the name of the numeric parameter used to select the plural
+	// form of the message, using the CLDR plural rules of the locale.
+	// If empty, the Other form is always used.
+	Plural string
+
+	// Plural forms of the message. Other is used if the selected form
+	// is not set.
+	Zero  string
+	One   string
+	Two   string
+	Few   string
+	Many  string
+	Other string
+}
+
+func (m Message) form(f plural.Form) string {
+	var tmpl string
+	switch f {
+	case plural.Zero:
+		tmpl = m.Zero
+	case plural.One:
+		tmpl = m.One
+	case plural.Two:
+		tmpl = m.Two
+	case plural.Few:
+		tmpl = m.Few
+	case plural.Many:
+		tmpl = m.Many
+	}
+	if tmpl == "" {
+		return m.Other
+	}
+
+	return tmpl
+}
+
+// Catalog contains localized message templates, indexed by locale and
+// error code. It is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[language.Tag]map[string]Message
+}
+
+// NewCatalog returns a new empty message catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{
+		messages: map[language.Tag]map[string]Message{},
+	}
+}
+
+// Set adds the message template for the specified locale and error code
+// to the catalog, replacing the existing one, if any.
+func (c *Catalog) Set(locale, code string, msg Message) error {
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.messages[tag] == nil {
+		c.messages[tag] = map[string]Message{}
+	}
+	c.messages[tag][code] = msg
+
+	return nil
+}
+
+// Localize returns a copy of err with the message replaced by the template
+// registered for its code in the specified locale. If the locale has no
+// template for the code, its parent locales are used (e.g. `de` for
+// `de-AT`). Errors of type Errors are localized individually. Other errors,
+// or errors for which no template is found, are returned unchanged.
+func (c *Catalog) Localize(err error, locale string) error {
+	tag, parseErr := parseLocale(locale)
+	if parseErr != nil {
+		return err
+	}
+
+	switch e := err.(type) {
+	case Errors:
+		errs := make(Errors, len(e))
+		for i, err := range e {
+			errs[i] = c.Localize(err, locale)
+		}
+		return errs
+	case *Error:
+		msg, ok := c.lookup(tag, e.Code)
+		if !ok {
+			return err
+		}
+
+		le := *e
+		le.Message, le.format, le.args = c.render(tag, msg, e), "", nil
+		return &le
+	}
+
+	return err
+}
+
+func (c *Catalog) lookup(tag language.Tag, code string) (Message, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for {
+		if msg, ok := c.messages[tag][code]; ok {
+			return msg, true
+		}
+		if tag == language.Und {
+			return Message{}, false
+		}
+		tag = tag.Parent()
+	}
+}
+
+func (c *Catalog) render(tag language.Tag, msg Message, e *Error) string {
+	tmpl := msg.Other
+	if msg.Plural != "" {
+		if i, v, w, f, t, ok := pluralOperands(e.Params[msg.Plural]); ok {
+			tmpl = msg.form(plural.Cardinal.MatchPlural(tag, i, v, w, f, t))
+		}
+	}
+
+	fmtg := globalFormatting().override(formatting{locale: tag})
+	return regPlaceholder.ReplaceAllStringFunc(tmpl, func(ph string) string {
+		var value interface{}
+		switch name := ph[1 : len(ph)-1]; name {
+		case "field":
+			value = e.Field
+		case "value":
+			value = e.Value
+		default:
+			var ok bool
+			if value, ok = e.Params[name]; !ok {
+				return ph
+			}
+		}
+
+		return fmt.Sprintf("%v", formattedValue{value: value, formatting: fmtg})
+	})
+}
+
+// pluralOperands returns the CLDR plural operands of a numeric value:
+// the integer digits (i), the number of visible fraction digits with (v)
+// and without (w) trailing zeros and the visible fraction digits with (f)
+// and without (t) trailing zeros.
+func pluralOperands(x interface{}) (i, v, w, f, t int, ok bool) {
+	var str string
+
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		str = strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		str = strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) || math.IsInf(rv.Float(), 0) {
+			return 0, 0, 0, 0, 0, false
+		}
+		str = strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	default:
+		return 0, 0, 0, 0, 0, false
+	}
+
+	intPart, fracPart, _ := strings.Cut(strings.TrimPrefix(str, "-"), ".")
+	if i, ok = atoiOperand(intPart); !ok {
+		return 0, 0, 0, 0, 0, false
+	}
+
+	trimmed := strings.TrimRight(fracPart, "0")
+	v, w = len(fracPart), len(trimmed)
+	f, _ = atoiOperand(fracPart)
+	t, _ = atoiOperand(trimmed)
+
+	return i, v, w, f, t, true
+}
+
+func atoiOperand(s string) (int, bool) {
+	if s == "" {
+		return 0, true
+	}
+
+	// Operands exceeding the int range only need to preserve the digits
+	// used by the plural rules.
+	if len(s) > 9 {
+		s = s[len(s)-9:]
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
@@ -0,0 +1,113 @@
+package check
+
+import "unicode"
+
+// charsValidator returns a validation function which checks if all the
+// characters of the s parameter satisfy the predicate.
+func charsValidator(s string, required bool, code, desc string, pred func(r rune) bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "value cannot be empty")
+		}
+
+		for _, r := range s {
+			if !pred(r) {
+				return newError(code, s, nil, "`%s` must contain only %s", s, desc)
+			}
+		}
+
+		return nil
+	}
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// Alpha checks if the s parameter contains only ASCII letters.
+// The value can be empty if the required parameter is false.
+func Alpha(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeAlpha, "letters", isASCIILetter)
+}
+
+// AlphaUnicode checks if the s parameter contains only Unicode letters.
+// The value can be empty if the required parameter is false.
+func AlphaUnicode(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeAlpha, "letters", unicode.IsLetter)
+}
+
+// Alphanumeric checks if the s parameter contains only ASCII letters and
+// digits. The value can be empty if the required parameter is false.
+func Alphanumeric(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeAlphanumeric, "letters and digits", func(r rune) bool {
+		return isASCIILetter(r) || isASCIIDigit(r)
+	})
+}
+
+// AlphanumericUnicode checks if the s parameter contains only Unicode
+// letters and decimal digits. The value can be empty if the required
+// parameter is false.
+func AlphanumericUnicode(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeAlphanumeric, "letters and digits", func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+// Numeric checks if the s parameter contains only ASCII digits.
+// The value can be empty if the required parameter is false.
+func Numeric(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeNumeric, "digits", isASCIIDigit)
+}
+
+// NumericUnicode checks if the s parameter contains only Unicode decimal
+// digits (e.g. `٣`). The value can be empty if the required parameter
+// is false.
+func NumericUnicode(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeNumeric, "digits", unicode.IsDigit)
+}
+
+// ASCII checks if the s parameter contains only ASCII characters.
+// The value can be empty if the required parameter is false.
+func ASCII(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeASCII, "ASCII characters", func(r rune) bool {
+		return r <= unicode.MaxASCII
+	})
+}
+
+// PrintableASCII checks if the s parameter contains only printable ASCII
+// characters, including spaces. The value can be empty if the required
+// parameter is false.
+func PrintableASCII(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodePrintableASCII, "printable ASCII characters", func(r rune) bool {
+		return r >= ' ' && r <= '~'
+	})
+}
+
+// Printable checks if the s parameter contains only printable Unicode
+// characters, as defined by unicode.IsPrint. The value can be empty if the
+// required parameter is false.
+func Printable(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodePrintable, "printable characters", unicode.IsPrint)
+}
+
+// Lowercase checks if the s parameter does not contain uppercase or
+// titlecase Unicode letters. The value can be empty if the required
+// parameter is false.
+func Lowercase(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeLowercase, "lowercase characters", func(r rune) bool {
+		return !unicode.IsUpper(r) && !unicode.IsTitle(r)
+	})
+}
+
+// Uppercase checks if the s parameter does not contain lowercase or
+// titlecase Unicode letters. The value can be empty if the required
+// parameter is false.
+func Uppercase(s string, required bool) ValidateFunc {
+	return charsValidator(s, required, CodeUppercase, "uppercase characters", func(r rune) bool {
+		return !unicode.IsLower(r) && !unicode.IsTitle(r)
+	})
+}
@@ -6,6 +6,18 @@ type ValidateFunc func() error
 // Run executes a list of validation functions and checks if any of them fail.
 // Returns the first error it encounters.
 func Run(vfs ...ValidateFunc) error {
+	for _, vf := range vfs {
+		if err := runCheck(vf, vf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run executes the validation functions like Run, without notifying the
+// observers. It is used by the validators which execute nested functions.
+func run(vfs ...ValidateFunc) error {
 	for _, vf := range vfs {
 		if err := vf(); err != nil {
 			return err
@@ -14,3 +26,78 @@ func Run(vfs ...ValidateFunc) error {
 
 	return nil
 }
+
+// Report executes all the validation functions, unlike Run, and returns
+// a result containing the errors of the failed ones. Errors of type Errors
+// are flattened and errors which are not of type *Error are converted to
+// an *Error with the CodeInvalid code. The result can be encoded as JSON,
+// in order to be returned by APIs:
+//
+//	{"valid": false, "errors": [{"field": "email", "code": "email", "message": "..."}]}
+func Report(vfs ...ValidateFunc) *Result {
+	result := &Result{}
+	for _, vf := range vfs {
+		if err := runCheck(vf, vf); err != nil {
+			for _, err := range flattenErrors(err) {
+				result.Errors = append(result.Errors, toError(err))
+			}
+		}
+	}
+	result.Valid = len(result.Errors) == 0
+
+	return result
+}
+
+// Runner executes validation functions using its own configuration,
+// instead of the global one. Unset fields fall back to the global
+// configuration.
+type Runner struct {
+	// Limits restricts how much of the validated values is included in
+	// the messages of the returned errors.
+	Limits Limits
+
+	// Locale is used to format the values included in the messages of
+	// the returned errors (e.g. `de-DE`).
+	Locale string
+
+	middlewares []Middleware
+}
+
+// Middleware wraps the execution of a validation function, in order to add
+// behaviour such as logging, metrics, caching or timeouts. A middleware
+// calls next to execute the wrapped function and can inspect or replace the
+// returned error.
+type Middleware func(next ValidateFunc) ValidateFunc
+
+// Use appends the specified middlewares to the chain wrapping each of the
+// validation functions executed by the runner. The first middleware is the
+// outermost one. Use is not safe for concurrent use with Run.
+func (r *Runner) Use(mws ...Middleware) *Runner {
+	r.middlewares = append(r.middlewares, mws...)
+	return r
+}
+
+// Run executes a list of validation functions and checks if any of them fail.
+// Returns the first error it encounters.
+func (r *Runner) Run(vfs ...ValidateFunc) error {
+	for _, vf := range vfs {
+		wrapped := vf
+		for i := len(r.middlewares) - 1; i >= 0; i-- {
+			wrapped = r.middlewares[i](wrapped)
+		}
+		if err := runCheck(vf, wrapped); err != nil {
+			return r.format(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runner) format(err error) error {
+	locale, lerr := parseLocale(r.Locale)
+	if lerr != nil {
+		return lerr
+	}
+
+	return withFormatting(err, formatting{limits: r.Limits, locale: locale})
+}
@@ -0,0 +1,308 @@
+// Package checkanalyzer defines an analyzer which reports common misuses of
+// the check package:
+//
+//   - comparison validators (e.g. Eq, Lt, Between) called with values of
+//     incompatible types, which always fail at runtime.
+//   - invalid regular expression literals passed to Matches, or declared in
+//     the `match` rule of a struct tag.
+//   - unknown rules in `check` struct tags. Rules registered by the analyzed
+//     program can be declared using the -rules flag.
+//   - validation functions which are created, but never called.
+//   - validators called with a literal false required flag for values which
+//     are also checked by Required in the same call, meaning the flag does
+//     not reflect that the values are required.
+package checkanalyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/adrg/check"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	checkPath     = "github.com/adrg/check"
+	checkfastPath = "github.com/adrg/check/checkfast"
+)
+
+// Analyzer reports common misuses of the check package.
+var Analyzer = &analysis.Analyzer{
+	Name:     "checkanalyzer",
+	Doc:      "report misuses of the github.com/adrg/check validation package",
+	URL:      "https://pkg.go.dev/github.com/adrg/check/checkanalyzer",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var customRules string
+
+func init() {
+	Analyzer.Flags.StringVar(&customRules, "rules", "",
+		"comma-separated list of custom struct tag rules registered by the program")
+}
+
+// cmpFuncs contains the number of compared arguments of the comparison
+// validators of the check package.
+var cmpFuncs = map[string]int{
+	"Eq":      2,
+	"Ne":      2,
+	"Lt":      2,
+	"Lte":     2,
+	"Gt":      2,
+	"Gte":     2,
+	"Between": 3,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	custom := map[string]bool{}
+	for _, name := range strings.Split(customRules, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			custom[name] = true
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+		(*ast.ExprStmt)(nil),
+		(*ast.Field)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			checkCall(pass, n)
+		case *ast.ExprStmt:
+			checkUnused(pass, n)
+		case *ast.Field:
+			checkTag(pass, n, custom)
+		}
+	})
+
+	return nil, nil
+}
+
+// callee returns the package path and the name of the function called
+// by call, if it is a function of the check packages.
+func callee(pass *analysis.Pass, call *ast.CallExpr) (string, string, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return "", "", false
+	}
+
+	path := fn.Pkg().Path()
+	if path != checkPath && path != checkfastPath {
+		return "", "", false
+	}
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return "", "", false
+	}
+
+	return path, fn.Name(), true
+}
+
+func checkCall(pass *analysis.Pass, call *ast.CallExpr) {
+	path, name, ok := callee(pass, call)
+	if !ok {
+		return
+	}
+
+	checkRequiredFlags(pass, call)
+
+	switch {
+	case path == checkPath && cmpFuncs[name] > 0 && len(call.Args) == cmpFuncs[name]:
+		checkCmpArgs(pass, name, call.Args)
+	case path == checkPath && name == "Matches" && len(call.Args) == 3:
+		checkPattern(pass, call.Args[1])
+	case path == checkfastPath && (name == "Compile" || name == "MustCompile") && len(call.Args) == 1:
+		checkPattern(pass, call.Args[0])
+	}
+}
+
+// checkCmpArgs reports comparison validators whose arguments belong to
+// different type classes. Such comparisons always fail, as the check package
+// does not convert between them.
+func checkCmpArgs(pass *analysis.Pass, name string, args []ast.Expr) {
+	class := typeClass(pass.TypesInfo.TypeOf(args[0]))
+	if class == "" {
+		return
+	}
+
+	for _, arg := range args[1:] {
+		if argClass := typeClass(pass.TypesInfo.TypeOf(arg)); argClass != "" && argClass != class {
+			pass.Reportf(arg.Pos(), "check.%s compares %s and %s values; the comparison always fails",
+				name, class, argClass)
+		}
+	}
+}
+
+// typeClass returns the class of values of type t which can be compared
+// with each other by the check package. An empty class is returned for
+// types whose values are compared by equality.
+func typeClass(t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	t = types.Default(t)
+
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return "time"
+		}
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return ""
+	}
+
+	info := basic.Info()
+	switch {
+	case info&types.IsUnsigned != 0:
+		return "unsigned integer"
+	case info&types.IsInteger != 0:
+		return "integer"
+	case info&types.IsFloat != 0:
+		return "floating-point"
+	case info&types.IsString != 0:
+		return "string"
+	}
+
+	return ""
+}
+
+// checkRequiredFlags reports the arguments of call which are validators
+// called with a literal false required flag for a value which is also
+// checked by another argument, using Required. The flag should be true, as
+// the value is required, and the Required check is then redundant.
+func checkRequiredFlags(pass *analysis.Pass, call *ast.CallExpr) {
+	required := map[string]bool{}
+	for _, arg := range call.Args {
+		argCall, ok := arg.(*ast.CallExpr)
+		if !ok || len(argCall.Args) != 1 {
+			continue
+		}
+		if path, name, ok := callee(pass, argCall); ok && path == checkPath && name == "Required" {
+			required[types.ExprString(argCall.Args[0])] = true
+		}
+	}
+	if len(required) == 0 {
+		return
+	}
+
+	for _, arg := range call.Args {
+		argCall, ok := arg.(*ast.CallExpr)
+		if !ok || len(argCall.Args) == 0 {
+			continue
+		}
+		path, name, ok := callee(pass, argCall)
+		if !ok || path != checkPath {
+			continue
+		}
+
+		value := types.ExprString(argCall.Args[0])
+		if idx := requiredParam(pass, argCall); idx > 0 && required[value] && isFalse(pass, argCall.Args[idx]) {
+			pass.Reportf(argCall.Args[idx].Pos(),
+				"check.%s accepts an empty %s, which is required by check.Required; pass true as the required flag",
+				name, value)
+		}
+	}
+}
+
+// requiredParam returns the index of the boolean parameter named required
+// of the function called by call, or -1 if there is no such parameter.
+func requiredParam(pass *analysis.Pass, call *ast.CallExpr) int {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return -1
+	}
+
+	params := fn.Type().(*types.Signature).Params()
+	for i := 0; i < params.Len() && i < len(call.Args); i++ {
+		param := params.At(i)
+		if basic, ok := param.Type().(*types.Basic); ok && basic.Kind() == types.Bool && param.Name() == "required" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isFalse(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.Bool && !constant.BoolVal(tv.Value)
+}
+
+func checkPattern(pass *analysis.Pass, arg ast.Expr) {
+	tv, ok := pass.TypesInfo.Types[arg]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+
+	if _, err := regexp.Compile(constant.StringVal(tv.Value)); err != nil {
+		pass.Reportf(arg.Pos(), "invalid regular expression: %v", err)
+	}
+}
+
+// checkUnused reports validation functions which are created, but not
+// called or used, meaning the validation never runs.
+func checkUnused(pass *analysis.Pass, stmt *ast.ExprStmt) {
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	if _, _, ok := callee(pass, call); !ok {
+		return
+	}
+
+	named, ok := pass.TypesInfo.TypeOf(call).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != checkPath {
+		return
+	}
+	if name := named.Obj().Name(); name == "ValidateFunc" || name == "ValidateCtxFunc" {
+		pass.Reportf(call.Pos(), "result of %s is not used; the validation never runs",
+			types.ExprString(call.Fun))
+	}
+}
+
+func checkTag(pass *analysis.Pass, field *ast.Field, custom map[string]bool) {
+	if field.Tag == nil {
+		return
+	}
+	raw, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return
+	}
+
+	tag, ok := reflect.StructTag(raw).Lookup("check")
+	if !ok || tag == "-" {
+		return
+	}
+
+	rules, err := check.ParseTag(tag)
+	if err != nil {
+		pass.Reportf(field.Tag.Pos(), "%v", err)
+		return
+	}
+	for _, rule := range rules {
+		if !check.HasRule(rule.Name) && !custom[rule.Name] {
+			pass.Reportf(field.Tag.Pos(), "unknown rule `%s` in check struct tag", rule.Name)
+			continue
+		}
+		if rule.Name == "match" {
+			if _, err := regexp.Compile(rule.Param); err != nil {
+				pass.Reportf(field.Tag.Pos(), "invalid regular expression in rule `match`: %v", err)
+			}
+		}
+	}
+}
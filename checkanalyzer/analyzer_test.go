@@ -0,0 +1,16 @@
+package checkanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/adrg/check/checkanalyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	if err := checkanalyzer.Analyzer.Flags.Set("rules", "slug"); err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, analysistest.TestData(), checkanalyzer.Analyzer, "a")
+}
@@ -0,0 +1,14 @@
+// Command checkanalyzer reports misuses of the github.com/adrg/check
+// validation package. It can be run directly or using go vet:
+//
+//	go vet -vettool=$(which checkanalyzer) ./...
+package main
+
+import (
+	"github.com/adrg/check/checkanalyzer"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(checkanalyzer.Analyzer)
+}
@@ -0,0 +1,17 @@
+module github.com/adrg/check/checkanalyzer
+
+go 1.22.0
+
+require (
+	github.com/adrg/check v0.0.0
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/adrg/check => ../
@@ -0,0 +1,43 @@
+package a
+
+import (
+	"time"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkfast"
+)
+
+type User struct {
+	Name    string `check:"required,max_len=64"`
+	Email   string `check:"required,emial"` // want "unknown rule `emial` in check struct tag"
+	Code    string `check:"match=[a-z"`     // want "invalid regular expression in rule `match`"
+	Role    string `check:"slug"`
+	Ignored string `check:"-"`
+}
+
+var code = checkfast.MustCompile(`(abc`) // want "invalid regular expression"
+
+func validate(u User, age int, score float64, n uint, at time.Time) error {
+	check.Email(u.Email, true) // want "result of check.Email is not used"
+
+	return check.Run(
+		check.Eq(u.Name, "Bond"),
+		check.Eq(age, 18),
+		check.Lt(age, 18.5),          // want "check.Lt compares integer and floating-point values"
+		check.Lt(n, age),             // want "check.Lt compares unsigned integer and integer values"
+		check.Between(score, 0.0, 1), // want "check.Between compares floating-point and integer values"
+		check.Lt(at, time.Now()),
+		check.Lt(at, "2025-01-01"), // want "check.Lt compares time and string values"
+		check.Matches(u.Code, `^\d+$`, true),
+		check.Matches(u.Code, `^\d+($`, true), // want "invalid regular expression"
+	)
+}
+
+func validateContact(u User, website string) error {
+	return check.Run(
+		check.Required(u.Email),
+		check.Email(u.Email, false), // want "check.Email accepts an empty u.Email, which is required by check.Required"
+		check.Matches(u.Code, `^\d+$`, false),
+		check.URL(website, false),
+	)
+}
@@ -0,0 +1,13 @@
+// Package check is a stub of the check package used by the analyzer tests.
+package check
+
+type ValidateFunc func() error
+
+func Run(vfs ...ValidateFunc) error                           { return nil }
+func Required(x interface{}) ValidateFunc                     { return nil }
+func Eq(x, term interface{}) ValidateFunc                     { return nil }
+func Lt(x, term interface{}) ValidateFunc                     { return nil }
+func Between(x, lower, upper interface{}) ValidateFunc        { return nil }
+func Matches(val, pattern string, required bool) ValidateFunc { return nil }
+func Email(email string, required bool) ValidateFunc          { return nil }
+func URL(url string, required bool) ValidateFunc              { return nil }
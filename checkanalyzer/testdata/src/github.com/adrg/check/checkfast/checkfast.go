@@ -0,0 +1,7 @@
+// Package checkfast is a stub of the checkfast package used by the analyzer
+// tests.
+package checkfast
+
+type Pattern struct{}
+
+func MustCompile(pattern string) *Pattern { return nil }
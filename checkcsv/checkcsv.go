@@ -0,0 +1,318 @@
+// Package checkcsv validates CSV documents row by row, based on a schema
+// describing the types and validation rules of their columns. Documents are
+// streamed, so they can be arbitrarily large. The errors are addressed by
+// row and column and the valid rows can be written, with their values
+// normalized, to a separate output.
+package checkcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrg/check"
+)
+
+// Type represents the type the values of a column are converted to, before
+// being validated.
+type Type int
+
+// Column types.
+const (
+	String Type = iota
+	Int
+	Float
+	Bool
+	Time
+)
+
+// Column describes a column of a CSV document.
+type Column struct {
+	// Name is the name of the column, matched against the header of the
+	// document.
+	Name string
+
+	// Type is the type the values of the column are converted to. Values
+	// which cannot be converted are reported using errors with the
+	// check.CodeInvalid code.
+	Type Type
+
+	// Layout is the layout of the values of Time columns (see time.Parse).
+	// Defaults to time.RFC3339.
+	Layout string
+
+	// Rules contains the validation functions for the converted values of
+	// the column (e.g. check.Rules("required,email")). Empty cells are
+	// validated as nil values, except for String columns, whose empty cells
+	// are validated as empty strings.
+	Rules []check.ValueFunc
+}
+
+// Schema describes the columns of CSV documents. The zero value of the
+// optional fields is usable.
+type Schema struct {
+	// Columns contains the columns of the documents. Columns of the
+	// documents which are not part of the schema are ignored.
+	Columns []Column
+
+	// NoHeader indicates that the documents do not start with a header.
+	// In that case, the columns of the schema are matched by position.
+	NoHeader bool
+
+	// Comma is the field delimiter. Defaults to `,`.
+	Comma rune
+
+	// MaxErrors stops the validation once the specified number of errors
+	// has been collected. Zero means no limit.
+	MaxErrors int
+}
+
+// Result contains the results of the validation of a CSV document.
+type Result struct {
+	// Rows is the number of validated rows, excluding the header.
+	Rows int
+
+	// Invalid is the number of invalid rows.
+	Invalid int
+
+	// Truncated reports whether the validation was stopped early, because
+	// the maximum number of errors was reached.
+	Truncated bool
+
+	// Failures contains the failed checks. The row of each failure is the
+	// line number of the row in the document, starting from 1, and the
+	// field is the name of the column.
+	Failures []check.Failure
+}
+
+// Valid reports whether all the validated rows are valid.
+func (r *Result) Valid() bool {
+	return r.Invalid == 0
+}
+
+// Err returns the failed checks as check.Errors, with the row and column of
+// each failure used as a field prefix (e.g. `[3].email`). Returns nil if all
+// the rows are valid.
+func (r *Result) Err() error {
+	if len(r.Failures) == 0 {
+		return nil
+	}
+
+	errs := make(check.Errors, len(r.Failures))
+	for i, f := range r.Failures {
+		errs[i] = &check.Error{
+			Code:    f.Code,
+			Field:   fmt.Sprintf("[%d].%s", f.Row, f.Field),
+			Message: f.Message,
+		}
+	}
+
+	return errs
+}
+
+// Validate reads the CSV document from r and validates its rows. If clean
+// is not nil, a header followed by the valid rows are written to it in the
+// CSV format, containing only the columns of the schema, in the order of
+// the schema, with their values normalized (e.g. surrounding whitespace is
+// removed and numbers are written in canonical form).
+//
+// The returned error is only set if the document cannot be read or written,
+// or if the header is missing columns of the schema. Validation failures
+// are reported in the returned result.
+func (s *Schema) Validate(r io.Reader, clean io.Writer) (*Result, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	if s.Comma != 0 {
+		cr.Comma = s.Comma
+	}
+
+	var cw *csv.Writer
+	if clean != nil {
+		cw = csv.NewWriter(clean)
+		if s.Comma != 0 {
+			cw.Comma = s.Comma
+		}
+	}
+
+	indexes, err := s.columnIndexes(cr)
+	if err != nil {
+		return nil, err
+	}
+	if cw != nil {
+		header := make([]string, len(s.Columns))
+		for i, col := range s.Columns {
+			header[i] = col.Name
+		}
+		if err := cw.Write(header); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &Result{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+		line, _ := cr.FieldPos(0)
+		result.Rows++
+
+		cleaned, failures := s.validateRow(record, indexes, line)
+		if len(failures) > 0 {
+			result.Invalid++
+			if s.MaxErrors > 0 && len(result.Failures)+len(failures) >= s.MaxErrors {
+				result.Failures = append(result.Failures, failures[:s.MaxErrors-len(result.Failures)]...)
+				result.Truncated = true
+				break
+			}
+			result.Failures = append(result.Failures, failures...)
+			continue
+		}
+		if cw != nil {
+			if err := cw.Write(cleaned); err != nil {
+				return result, err
+			}
+		}
+	}
+	if cw != nil {
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// columnIndexes returns the indexes of the columns of the schema in the
+// records of the document.
+func (s *Schema) columnIndexes(cr *csv.Reader) ([]int, error) {
+	indexes := make([]int, len(s.Columns))
+	if s.NoHeader {
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes, nil
+	}
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("checkcsv: missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	positions := map[string]int{}
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		positions[strings.TrimSpace(name)] = i
+	}
+	for i, col := range s.Columns {
+		idx, ok := positions[col.Name]
+		if !ok {
+			return nil, fmt.Errorf("checkcsv: missing column %q", col.Name)
+		}
+		indexes[i] = idx
+	}
+
+	return indexes, nil
+}
+
+func (s *Schema) validateRow(record []string, indexes []int, line int) ([]string, []check.Failure) {
+	var failures []check.Failure
+	cleaned := make([]string, len(s.Columns))
+	for i, col := range s.Columns {
+		var cell string
+		if idx := indexes[i]; idx < len(record) {
+			cell = strings.TrimSpace(record[idx])
+		}
+
+		x, text, err := col.convert(cell)
+		if err == nil {
+			vfs := make([]check.ValidateFunc, len(col.Rules))
+			for j, fn := range col.Rules {
+				vfs[j] = fn(x)
+			}
+			err = check.Run(vfs...)
+		}
+		if err != nil {
+			failures = append(failures, failure(line, col.Name, err))
+			continue
+		}
+		cleaned[i] = text
+	}
+
+	return cleaned, failures
+}
+
+// convert converts the cell to the type of the column. Returns the
+// converted value and its normalized text.
+func (c Column) convert(cell string) (interface{}, string, error) {
+	if c.Type == String {
+		return cell, cell, nil
+	}
+	if cell == "" {
+		return nil, "", nil
+	}
+
+	var x interface{}
+	var text, desc string
+	var err error
+	switch c.Type {
+	case Int:
+		var n int64
+		n, err = strconv.ParseInt(cell, 10, 64)
+		x, text, desc = n, strconv.FormatInt(n, 10), "integer"
+	case Float:
+		var f float64
+		f, err = strconv.ParseFloat(cell, 64)
+		x, text, desc = f, strconv.FormatFloat(f, 'f', -1, 64), "number"
+	case Bool:
+		var b bool
+		b, err = strconv.ParseBool(cell)
+		x, text, desc = b, strconv.FormatBool(b), "boolean"
+	case Time:
+		layout := c.Layout
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		var t time.Time
+		t, err = time.Parse(layout, cell)
+		x, text, desc = t, t.Format(layout), "time"
+	default:
+		return nil, "", fmt.Errorf("checkcsv: unknown type of column %q", c.Name)
+	}
+	if err != nil {
+		return nil, "", &check.Error{
+			Code:    check.CodeInvalid,
+			Value:   cell,
+			Message: "`" + cell + "` is not a valid " + desc,
+		}
+	}
+
+	return x, text, nil
+}
+
+func failure(line int, column string, err error) check.Failure {
+	f := check.Failure{Row: line, Field: column, Code: check.CodeInvalid, Message: err.Error()}
+
+	var e *check.Error
+	if errors.As(err, &e) {
+		f.Code, f.Message = e.Code, e.Message
+		if e.Field != "" {
+			f.Field = column + "." + e.Field
+		}
+	}
+
+	return f
+}
@@ -0,0 +1,46 @@
+package checkcsv_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkcsv"
+)
+
+func ExampleSchema_Validate() {
+	schema := &checkcsv.Schema{
+		Columns: []checkcsv.Column{
+			{Name: "email", Rules: []check.ValueFunc{check.Rules("required,email")}},
+			{Name: "age", Type: checkcsv.Int, Rules: []check.ValueFunc{check.Rules("gte=18")}},
+			{Name: "active", Type: checkcsv.Bool},
+		},
+	}
+
+	doc := `name,email,age,active
+John, john.doe@example.com ,042,1
+Jane,jane.doe,17,true
+Alice,alice@example.com,abc,no
+Bob,bob@example.com,,F
+`
+	result, err := schema.Validate(strings.NewReader(doc), os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range result.Failures {
+		fmt.Printf("line %d, column %s: %s\n", f.Row, f.Field, f.Message)
+	}
+	fmt.Printf("%d rows, %d invalid\n", result.Rows, result.Invalid)
+
+	// Output:
+	// email,age,active
+	// john.doe@example.com,42,true
+	// bob@example.com,,false
+	// line 3, column email: invalid email address `jane.doe`
+	// line 3, column age: `gte` comparison failed: `17` is not greater than or equal to `18`
+	// line 4, column age: `abc` is not a valid integer
+	// line 4, column active: `no` is not a valid boolean
+	// 4 rows, 2 invalid
+}
@@ -0,0 +1,41 @@
+package checkfast_test
+
+import (
+	"testing"
+
+	"github.com/adrg/check/checkfast"
+)
+
+func TestZeroAllocs(t *testing.T) {
+	name, code := "Bond", "ABC-007"
+	pattern := checkfast.MustCompile(`^[A-Z]{3}-\d{3}$`)
+
+	tests := map[string]func() error{
+		"Required":     func() error { return checkfast.Required(name) },
+		"RequiredPtr":  func() error { return checkfast.Required(&name) },
+		"Len":          func() error { return checkfast.Len(code, 7) },
+		"MinLen":       func() error { return checkfast.MinLen(name, 1) },
+		"MaxLen":       func() error { return checkfast.MaxLen(name, 64) },
+		"LenBetween":   func() error { return checkfast.LenBetween(name, 1, 64) },
+		"Eq":           func() error { return checkfast.Eq(name, "Bond") },
+		"Ne":           func() error { return checkfast.Ne(code, "") },
+		"Lt":           func() error { return checkfast.Lt(7, 10) },
+		"Lte":          func() error { return checkfast.Lte(7.5, 7.5) },
+		"Gt":           func() error { return checkfast.Gt(uint8(7), 0) },
+		"Gte":          func() error { return checkfast.Gte(int64(7), 7) },
+		"Between":      func() error { return checkfast.Between(7, 1, 10) },
+		"Matches":      func() error { return pattern.Matches(code, true) },
+		"MatchesEmpty": func() error { return pattern.Matches(" ", false) },
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := fn(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if allocs := testing.AllocsPerRun(100, func() { _ = fn() }); allocs != 0 {
+				t.Errorf("got %v allocations, want 0", allocs)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Package checkfast implements the hot mode of the check package: a set of
+// the most commonly used validators which are guaranteed not to allocate
+// when the validation succeeds.
+//
+// Unlike the validators of the check package, which return a ValidateFunc
+// closure, the functions in this package perform the check immediately and
+// return the result. On failure, they return the same errors as their
+// check package counterparts, which may allocate.
+package checkfast
+
+import (
+	"cmp"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/adrg/check"
+)
+
+// Required checks if x is not empty, in the same way as check.Required.
+// Values are empty if they are the zero value of their type. Arrays and
+// channels are empty if their length is 0. Pointers and interfaces are empty
+// if they are nil or if the values they reference are empty.
+func Required[T comparable](x T) error {
+	var zero T
+	if x != zero && !isEmpty(x) {
+		return nil
+	}
+
+	return check.Required(x)()
+}
+
+// isEmpty reports whether the non-zero value x is empty (see Required).
+func isEmpty[T comparable](x T) bool {
+	switch t := reflect.TypeOf((*T)(nil)).Elem(); t.Kind() {
+	case reflect.Array:
+		return t.Len() == 0
+	case reflect.Chan, reflect.Ptr, reflect.Interface:
+		return isEmptyValue(reflect.ValueOf(any(x)))
+	}
+
+	return false
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil() || isEmptyValue(v.Elem())
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex() == 0
+	}
+	if v.Comparable() {
+		return v.Equal(reflect.Zero(v.Type()))
+	}
+
+	return check.Required(v.Interface())() != nil
+}
+
+// Len checks if the length of s, in runes, is equal to n.
+func Len(s string, n int) error {
+	if utf8.RuneCountInString(s) == n {
+		return nil
+	}
+
+	return check.Len(s, n)()
+}
+
+// MinLen checks if the length of s, in runes, is greater than or equal to n.
+func MinLen(s string, n int) error {
+	if utf8.RuneCountInString(s) >= n {
+		return nil
+	}
+
+	return check.MinLen(s, n)()
+}
+
+// MaxLen checks if the length of s, in runes, is less than or equal to n.
+func MaxLen(s string, n int) error {
+	if utf8.RuneCountInString(s) <= n {
+		return nil
+	}
+
+	return check.MaxLen(s, n)()
+}
+
+// LenBetween checks if the length of s, in runes, is greater than or equal
+// to the lower bound and less than or equal to the upper bound.
+func LenBetween(s string, lower, upper int) error {
+	if l := utf8.RuneCountInString(s); l >= lower && l <= upper {
+		return nil
+	}
+
+	return check.LenBetween(s, lower, upper)()
+}
+
+// Eq checks if x is equal to the comparison term.
+func Eq[T comparable](x, term T) error {
+	if x == term {
+		return nil
+	}
+
+	return check.EqT(x, term)()
+}
+
+// Ne checks if x is not equal to the comparison term.
+func Ne[T comparable](x, term T) error {
+	if x != term {
+		return nil
+	}
+
+	return check.NeT(x, term)()
+}
+
+// Lt checks if x is less than the comparison term.
+func Lt[T cmp.Ordered](x, term T) error {
+	if x < term {
+		return nil
+	}
+
+	return check.LtT(x, term)()
+}
+
+// Lte checks if x is less than or equal to the comparison term.
+func Lte[T cmp.Ordered](x, term T) error {
+	if x <= term {
+		return nil
+	}
+
+	return check.LteT(x, term)()
+}
+
+// Gt checks if x is greater than the comparison term.
+func Gt[T cmp.Ordered](x, term T) error {
+	if x > term {
+		return nil
+	}
+
+	return check.GtT(x, term)()
+}
+
+// Gte checks if x is greater than or equal to the comparison term.
+func Gte[T cmp.Ordered](x, term T) error {
+	if x >= term {
+		return nil
+	}
+
+	return check.GteT(x, term)()
+}
+
+// Between checks if x is greater than or equal to the lower bound and less
+// than or equal to the upper bound.
+func Between[T cmp.Ordered](x, lower, upper T) error {
+	if x >= lower && x <= upper {
+		return nil
+	}
+
+	return check.BetweenT(x, lower, upper)()
+}
+
+// Pattern is a precompiled regular expression used for matching values.
+type Pattern struct {
+	re *regexp.Regexp
+}
+
+// Compile parses the specified regular expression and returns a pattern
+// which can be used for matching values.
+func Compile(pattern string) (*Pattern, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pattern{re: re}, nil
+}
+
+// MustCompile is like Compile, but panics if the pattern cannot be parsed.
+func MustCompile(pattern string) *Pattern {
+	return &Pattern{re: regexp.MustCompile(pattern)}
+}
+
+// Matches checks if s matches the pattern. The string can be empty if the
+// required parameter is false.
+func (p *Pattern) Matches(s string, required bool) error {
+	if !required && strings.TrimSpace(s) == "" {
+		return nil
+	}
+	if s != "" && p.re.MatchString(s) {
+		return nil
+	}
+
+	return check.MatchesRegexp(s, p.re, required)()
+}
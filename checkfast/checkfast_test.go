@@ -0,0 +1,71 @@
+package checkfast_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkfast"
+)
+
+func TestRequired(t *testing.T) {
+	type point struct{ X, Y float64 }
+
+	zero, one := 0, 1
+	empty, name := "", "Bond"
+	var nilPtr *int
+	var nilIface error
+
+	tests := map[string]struct {
+		fast  func() error
+		value interface{}
+	}{
+		"empty string":     {func() error { return checkfast.Required("") }, ""},
+		"blank string":     {func() error { return checkfast.Required(" ") }, " "},
+		"string":           {func() error { return checkfast.Required("Bond") }, "Bond"},
+		"zero int":         {func() error { return checkfast.Required(0) }, 0},
+		"int":              {func() error { return checkfast.Required(7) }, 7},
+		"negative zero":    {func() error { return checkfast.Required(-1 * 0.0) }, -1 * 0.0},
+		"zero struct":      {func() error { return checkfast.Required(point{}) }, point{}},
+		"struct":           {func() error { return checkfast.Required(point{Y: 1}) }, point{Y: 1}},
+		"nil pointer":      {func() error { return checkfast.Required(nilPtr) }, nilPtr},
+		"pointer to zero":  {func() error { return checkfast.Required(&zero) }, &zero},
+		"pointer":          {func() error { return checkfast.Required(&one) }, &one},
+		"pointer to empty": {func() error { return checkfast.Required(&empty) }, &empty},
+		"pointer to name":  {func() error { return checkfast.Required(&name) }, &name},
+		"pointer to zero struct": {
+			func() error { return checkfast.Required(&point{}) }, &point{},
+		},
+		"nil interface": {func() error { return checkfast.Required(nilIface) }, nilIface},
+		"interface":     {func() error { return checkfast.Required[interface{}](0) }, 0},
+		"zero array":    {func() error { return checkfast.Required([2]int{}) }, [2]int{}},
+		"empty array":   {func() error { return checkfast.Required([0]int{}) }, [0]int{}},
+		"channel":       {func() error { return checkfast.Required(make(chan int)) }, make(chan int)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, want := tt.fast(), check.Required(tt.value)()
+			if (got == nil) != (want == nil) {
+				t.Fatalf("got error %v, want %v", got, want)
+			}
+			if got != nil && got.Error() != want.Error() {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPatternMatches(t *testing.T) {
+	pattern := checkfast.MustCompile(`^[A-Z]{3}-\d{3}$`)
+	re := regexp.MustCompile(`^[A-Z]{3}-\d{3}$`)
+
+	for _, s := range []string{"", "   ", "ABC-007", "abc-007"} {
+		for _, required := range []bool{false, true} {
+			got, want := pattern.Matches(s, required), check.MatchesRegexp(s, re, required)()
+			if (got == nil) != (want == nil) {
+				t.Errorf("Matches(%q, %t): got error %v, want %v", s, required, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package checkfast_test
+
+import (
+	"fmt"
+
+	"github.com/adrg/check/checkfast"
+)
+
+var reference = checkfast.MustCompile(`^[A-Z]{3}-\d{3}$`)
+
+func Example() {
+	type message struct {
+		Reference string
+		Sender    string
+		Priority  int
+	}
+
+	validate := func(m message) error {
+		if err := reference.Matches(m.Reference, true); err != nil {
+			return err
+		}
+		if err := checkfast.LenBetween(m.Sender, 1, 64); err != nil {
+			return err
+		}
+
+		return checkfast.Between(m.Priority, 1, 5)
+	}
+
+	for _, m := range []message{
+		{Reference: "ABC-007", Sender: "Bond", Priority: 1},
+		{Reference: "ABC-007", Sender: "Bond", Priority: 9},
+		{Reference: "abc", Sender: "Bond", Priority: 1},
+	} {
+		if err := validate(m); err != nil {
+			// Treat error.
+			fmt.Println(err)
+		}
+	}
+
+	// Output:
+	// `lte` comparison failed: `9` is not less than or equal to `5`
+	// `abc` does not match pattern `^[A-Z]{3}-\d{3}$`
+}
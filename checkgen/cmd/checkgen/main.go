@@ -0,0 +1,59 @@
+// Command checkgen generates reflection-free Validate methods for the struct
+// types of a Go source file, based on the rules declared in their `check`
+// struct tags (see the checkgen package). It is usually run using go generate:
+//
+//	//go:generate checkgen -type User,Address user.go
+//
+// By default, the methods are written to a file named after the source
+// file, with the `_check.go` suffix.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/adrg/check/checkgen"
+)
+
+func main() {
+	types := flag.String("type", "", "comma-separated list of struct type names (default: all struct types)")
+	output := flag.String("output", "", "output file name (default: <file>_check.go)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: checkgen [flags] file.go\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *types, *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(filename, types, output string) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if types != "" {
+		names = strings.Split(types, ",")
+	}
+
+	out, err := checkgen.Generate(filename, src, names...)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = strings.TrimSuffix(filename, ".go") + "_check.go"
+	}
+
+	return os.WriteFile(output, out, 0o644)
+}
@@ -0,0 +1,149 @@
+package checkgen_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkgen"
+	"github.com/adrg/check/checkgen/internal/fixture"
+)
+
+func ExampleGenerate() {
+	src := []byte(`package users
+
+type User struct {
+	Name  string ` + "`check:\"required,min_len=3\"`" + `
+	Email string ` + "`check:\"email\"`" + `
+	Age   int    ` + "`check:\"gte=18\"`" + `
+}
+`)
+
+	out, err := checkgen.Generate("user.go", src, "User")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(string(out))
+
+	// Output:
+	// // Code generated by checkgen. DO NOT EDIT.
+	//
+	// package users
+	//
+	// import (
+	// 	"github.com/adrg/check"
+	// 	"github.com/adrg/check/checkfast"
+	// )
+	//
+	// // Validate validates the fields of x, based on the rules declared in
+	// // their `check` struct tags.
+	// func (x *User) Validate() error {
+	// 	if err := checkfast.Required(x.Name); err != nil {
+	// 		return check.FieldError("Name", err)
+	// 	}
+	// 	if err := checkfast.MinLen(x.Name, 3); err != nil {
+	// 		return check.FieldError("Name", err)
+	// 	}
+	// 	if err := check.Email(x.Email, false)(); err != nil {
+	// 		return check.FieldError("Email", err)
+	// 	}
+	// 	if err := checkfast.Gte(x.Age, 18); err != nil {
+	// 		return check.FieldError("Age", err)
+	// 	}
+	// 	return nil
+	// }
+}
+
+func ExampleGenerate_in() {
+	src := []byte(`package billing
+
+type Plan struct {
+	Tier string  ` + "`check:\"in=free|pro|free\"`" + `
+	Rate float64 ` + "`check:\"not_in=1|1.0|-0|0\"`" + `
+}
+`)
+
+	out, err := checkgen.Generate("plan.go", src, "Plan")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(string(out))
+
+	// Output:
+	// // Code generated by checkgen. DO NOT EDIT.
+	//
+	// package billing
+	//
+	// import (
+	// 	"github.com/adrg/check"
+	// )
+	//
+	// // Validate validates the fields of x, based on the rules declared in
+	// // their `check` struct tags.
+	// func (x *Plan) Validate() error {
+	// 	switch x.Tier {
+	// 	case "free", "pro":
+	// 	default:
+	// 		return check.FieldError("Tier", check.In(x.Tier, "free", "pro", "free")())
+	// 	}
+	// 	switch x.Rate {
+	// 	case 1, 0:
+	// 		return check.FieldError("Rate", check.NotIn(x.Rate, float64(1), float64(1), float64(0), float64(0))())
+	// 	}
+	// 	return nil
+	// }
+}
+
+func ExampleGenerate_validate() {
+	// The Validate methods of the fixture package are generated by checkgen
+	// and return the same errors as check.Struct.
+	src, err := os.ReadFile("internal/fixture/fixture.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := checkgen.Generate("fixture.go", src, "Plan", "Account")
+	if err != nil {
+		log.Fatal(err)
+	}
+	generated, err := os.ReadFile("internal/fixture/fixture_check.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("up to date:", bytes.Equal(out, generated))
+
+	limit := uint16(15)
+	plans := []fixture.Plan{
+		{Rate: 1, Level: 1},
+		{Rate: 1.5, Count: 3, Level: 1},
+		{Rate: 1.5, Level: 4},
+		{Rate: 1.5, Level: 2, Limit: &limit},
+		{Rate: 1.5, Level: 3},
+	}
+	for _, plan := range plans {
+		fmt.Println(plan.Validate(), "|", check.Struct(plan))
+	}
+
+	accounts := []fixture.Account{
+		{Name: "a", Plan: plans[4]},
+		{Name: "b", Plan: plans[4], Addr: fixture.Address{City: "Paris"}, Billing: &fixture.Address{}},
+		{Name: "c", Plan: plans[4], Addr: fixture.Address{City: "Paris"}, Addresses: []*fixture.Address{nil, {}}},
+		{Name: "d", Plan: plans[4], Addr: fixture.Address{City: "Paris"}},
+	}
+	for _, account := range accounts {
+		fmt.Println(account.Validate(), "|", check.Struct(account))
+	}
+
+	// Output:
+	// up to date: true
+	// Rate: `not in` comparison failed: `1` in `[1 2]` | Rate: `not in` comparison failed: `1` in `[1 2]`
+	// Count: `not in` comparison failed: `3` in `[3]` | Count: `not in` comparison failed: `3` in `[3]`
+	// Level: `in` comparison failed: `4` not in `[1 2 3]` | Level: `in` comparison failed: `4` not in `[1 2 3]`
+	// Limit: `in` comparison failed: `15` not in `[10 20]` | Limit: `in` comparison failed: `15` not in `[10 20]`
+	// <nil> | <nil>
+	// Addr.City: empty argument | Addr.City: empty argument
+	// Billing.City: empty argument | Billing.City: empty argument
+	// Addresses[1].City: empty argument | Addresses[1].City: empty argument
+	// <nil> | <nil>
+}
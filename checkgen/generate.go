@@ -0,0 +1,545 @@
+// Package checkgen generates reflection-free Validate methods for struct
+// types, based on the rules declared in their `check` struct tags. The
+// generated methods call the typed validators of the check and checkfast
+// packages directly and return the same errors as check.Struct, which
+// makes them suitable for hot paths.
+//
+// Rules are translated to direct calls for fields of basic types (strings,
+// booleans and numbers) and pointers to them. Other rules and field types
+// are validated using check.Rules, which relies on reflection. Nested
+// structs declared in the same file are validated by calling their
+// generated Validate methods or, if no methods are generated for their
+// types, using check.Struct. The nested fields of types declared in other
+// packages are not validated. The `checkmsg` struct tags are not supported.
+package checkgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/adrg/check"
+)
+
+const (
+	checkImport     = "github.com/adrg/check"
+	checkfastImport = "github.com/adrg/check/checkfast"
+)
+
+// Validators of string values, by rule name. The validators accept
+// a string and a required flag and return a check.ValidateFunc.
+var stringValidators = map[string]string{
+	"alpha":        "Alpha",
+	"alphanumeric": "Alphanumeric",
+	"ascii":        "ASCII",
+	"base64":       "Base64",
+	"bic":          "BIC",
+	"date":         "DateISO8601",
+	"duration":     "Duration",
+	"email":        "Email",
+	"hex":          "Hex",
+	"hex_color":    "HexColor",
+	"iban":         "IBAN",
+	"ip":           "IP",
+	"isbn":         "ISBN",
+	"ksuid":        "KSUID",
+	"lowercase":    "Lowercase",
+	"mac":          "MAC",
+	"numeric":      "Numeric",
+	"phone":        "Phone",
+	"rfc3339":      "RFC3339",
+	"semver":       "Semver",
+	"ulid":         "ULID",
+	"uppercase":    "Uppercase",
+	"url":          "URL",
+	"uuid":         "UUID",
+}
+
+// Comparison functions of the checkfast package, by rule name.
+var cmpFuncs = map[string]string{
+	"eq":  "Eq",
+	"ne":  "Ne",
+	"lt":  "Lt",
+	"lte": "Lte",
+	"gt":  "Gt",
+	"gte": "Gte",
+}
+
+// Length functions of the checkfast package, by rule name.
+var lenFuncs = map[string]string{
+	"len":     "Len",
+	"min_len": "MinLen",
+	"max_len": "MaxLen",
+}
+
+type kind int
+
+const (
+	kindOther kind = iota
+	kindString
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindStruct
+)
+
+// fieldType describes the type of a struct field.
+type fieldType struct {
+	kind kind
+	name string
+	bits int
+	ptr  bool
+
+	// elem is the element type of slices.
+	elem *fieldType
+}
+
+var basicTypes = map[string]fieldType{
+	"string":  {kind: kindString},
+	"bool":    {kind: kindBool},
+	"int":     {kind: kindInt, bits: 64},
+	"int8":    {kind: kindInt, bits: 8},
+	"int16":   {kind: kindInt, bits: 16},
+	"int32":   {kind: kindInt, bits: 32},
+	"int64":   {kind: kindInt, bits: 64},
+	"uint":    {kind: kindUint, bits: 64},
+	"uint8":   {kind: kindUint, bits: 8},
+	"uint16":  {kind: kindUint, bits: 16},
+	"uint32":  {kind: kindUint, bits: 32},
+	"uint64":  {kind: kindUint, bits: 64},
+	"float32": {kind: kindFloat, bits: 32},
+	"float64": {kind: kindFloat, bits: 64},
+}
+
+type generator struct {
+	buf     bytes.Buffer
+	structs map[string]*ast.StructType
+	targets map[string]bool
+	imports map[string]bool
+
+	patterns     []string
+	patternNames map[string]int
+}
+
+// Generate returns the source of a Go file containing Validate methods for
+// the specified struct types, declared in the Go source file src. If no
+// types are specified, methods are generated for all the struct types of
+// the file. The methods have pointer receivers and return the first error
+// they encounter.
+func Generate(filename string, src []byte, types ...string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		structs:      map[string]*ast.StructType{},
+		targets:      map[string]bool{},
+		imports:      map[string]bool{},
+		patternNames: map[string]int{},
+	}
+
+	var names []string
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if st, ok := ts.Type.(*ast.StructType); ok && ts.TypeParams == nil {
+				g.structs[ts.Name.Name] = st
+				names = append(names, ts.Name.Name)
+			}
+		}
+	}
+
+	if len(types) > 0 {
+		names = types
+	}
+	for _, name := range names {
+		if _, ok := g.structs[name]; !ok {
+			return nil, fmt.Errorf("checkgen: struct type %s not found in %s", name, filename)
+		}
+		g.targets[name] = true
+	}
+
+	for _, name := range names {
+		if err := g.generateType(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.output(file.Name.Name)
+}
+
+func (g *generator) output(pkg string) ([]byte, error) {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by checkgen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			imports = append(imports, path)
+		}
+		sort.Slice(imports, func(i, j int) bool {
+			si, sj := strings.Contains(imports[i], "."), strings.Contains(imports[j], ".")
+			if si != sj {
+				return sj
+			}
+			return imports[i] < imports[j]
+		})
+
+		out.WriteString("import (\n")
+		for i, path := range imports {
+			// Separate the standard library imports from the others.
+			if i > 0 && strings.Contains(path, ".") != strings.Contains(imports[i-1], ".") {
+				out.WriteString("\n")
+			}
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+		out.WriteString(")\n\n")
+	}
+
+	if len(g.patterns) > 0 {
+		out.WriteString("var (\n")
+		for _, p := range g.patterns {
+			out.WriteString(p)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(g.buf.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generateType(name string) error {
+	g.printf("// Validate validates the fields of x, based on the rules declared in\n")
+	g.printf("// their `check` struct tags.\n")
+	g.printf("func (x *%s) Validate() error {\n", name)
+
+	for _, field := range g.structs[name].Fields.List {
+		var tag string
+		if field.Tag != nil {
+			unquoted, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return err
+			}
+			tag = reflect.StructTag(unquoted).Get("check")
+		}
+		if tag == "-" {
+			continue
+		}
+
+		rules, err := check.ParseTag(tag)
+		if err != nil {
+			return fmt.Errorf("checkgen: %s: %w", name, err)
+		}
+
+		ft := g.fieldType(field.Type)
+		if len(field.Names) == 0 {
+			// Embedded fields are named after their type and do not add
+			// a segment to the paths of the errors.
+			g.generateField(name, embeddedName(field.Type), "", ft, rules)
+			continue
+		}
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				g.generateField(name, ident.Name, ident.Name, ft, rules)
+			}
+		}
+	}
+
+	g.printf("return nil\n}\n\n")
+	return nil
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.Ident:
+		return e.Name
+	}
+
+	return ""
+}
+
+func (g *generator) fieldType(expr ast.Expr) fieldType {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ft, ok := basicTypes[e.Name]; ok {
+			ft.name = e.Name
+			return ft
+		}
+		if _, ok := g.structs[e.Name]; ok {
+			return fieldType{kind: kindStruct, name: e.Name}
+		}
+	case *ast.StarExpr:
+		ft := g.fieldType(e.X)
+		if ft.kind != kindOther && !ft.ptr && ft.elem == nil {
+			ft.ptr = true
+			return ft
+		}
+	case *ast.ArrayType:
+		if e.Len == nil {
+			elem := g.fieldType(e.Elt)
+			return fieldType{kind: kindOther, elem: &elem}
+		}
+	}
+
+	return fieldType{kind: kindOther}
+}
+
+func (g *generator) generateField(typeName, goName, label string, ft fieldType, rules []check.Rule) {
+	expr := "x." + goName
+	value := expr
+	if ft.ptr {
+		value = "*" + expr
+	}
+
+	var nested []check.Rule
+	for i, rule := range rules {
+		if ft.ptr && rule.Name == "required" {
+			g.imports[checkfastImport] = true
+			g.returnIfErr(label, "checkfast.Required("+expr+")")
+			continue
+		}
+		if ft.ptr {
+			nested = rules[i:]
+			break
+		}
+		g.generateRule(typeName, goName, label, value, ft, rule)
+	}
+
+	if !ft.ptr {
+		g.generateNested(label, expr, ft)
+		return
+	}
+
+	g.printf("if %s != nil {\n", expr)
+	for _, rule := range nested {
+		if rule.Name == "required" {
+			continue
+		}
+		g.generateRule(typeName, goName, label, value, ft, rule)
+	}
+	g.generateNested(label, expr, ft)
+	g.printf("}\n")
+}
+
+// generateNested generates the validation of the fields of nested structs.
+func (g *generator) generateNested(label, expr string, ft fieldType) {
+	switch {
+	case ft.kind == kindStruct:
+		g.returnIfErr(label, g.nestedCall(expr, ft))
+	case ft.elem != nil && ft.elem.kind == kindStruct:
+		g.imports[checkImport] = true
+		g.imports["strconv"] = true
+
+		elem := expr + "[i]"
+		if ft.elem.ptr {
+			g.printf("for i := range %s {\nif %s == nil {\ncontinue\n}\n", expr, elem)
+		} else {
+			g.printf("for i := range %s {\n", expr)
+		}
+		g.printf("if err := %s; err != nil {\n", g.nestedCall(elem, *ft.elem))
+		g.printf("return check.FieldError(%q + strconv.Itoa(i) + \"]\", err)\n}\n}\n", label+"[")
+	}
+}
+
+// nestedCall returns the call which validates the nested struct expr. The
+// structs of types without generated Validate methods are validated using
+// check.Struct.
+func (g *generator) nestedCall(expr string, ft fieldType) string {
+	if g.targets[ft.name] {
+		return expr + ".Validate()"
+	}
+
+	g.imports[checkImport] = true
+	return "check.Struct(" + expr + ")"
+}
+
+func (g *generator) returnIfErr(label, call string) {
+	g.printf("if err := %s; err != nil {\n", call)
+	if label == "" {
+		g.printf("return err\n}\n")
+		return
+	}
+
+	g.imports[checkImport] = true
+	g.printf("return check.FieldError(%q, err)\n}\n", label)
+}
+
+func (g *generator) generateRule(typeName, goName, label, value string, ft fieldType, rule check.Rule) {
+	if g.generateTypedRule(typeName, goName, label, value, ft, rule) {
+		return
+	}
+
+	// Fall back to the reflection-based rule.
+	g.imports[checkImport] = true
+
+	tag := rule.Name
+	if rule.Param != "" {
+		tag += "=" + rule.Param
+	}
+	g.returnIfErr(label, fmt.Sprintf("check.Rules(%q)(%s)()", tag, value))
+}
+
+func (g *generator) generateTypedRule(typeName, goName, label, value string, ft fieldType, rule check.Rule) bool {
+	if ft.kind == kindOther || ft.kind == kindStruct {
+		return false
+	}
+
+	switch {
+	case rule.Name == "required":
+		g.imports[checkfastImport] = true
+		g.returnIfErr(label, "checkfast.Required("+value+")")
+	case ft.kind == kindString && lenFuncs[rule.Name] != "":
+		if _, err := strconv.Atoi(rule.Param); err != nil {
+			return false
+		}
+		g.imports[checkfastImport] = true
+		g.returnIfErr(label, fmt.Sprintf("checkfast.%s(%s, %s)", lenFuncs[rule.Name], value, rule.Param))
+	case cmpFuncs[rule.Name] != "":
+		lit, ok := literal(ft, rule.Param)
+		if !ok || (ft.kind == kindBool && rule.Name != "eq" && rule.Name != "ne") {
+			return false
+		}
+		g.imports[checkfastImport] = true
+		g.returnIfErr(label, fmt.Sprintf("checkfast.%s(%s, %s)", cmpFuncs[rule.Name], value, lit))
+	case rule.Name == "in" || rule.Name == "not_in":
+		var lits []string
+		for _, param := range strings.Split(rule.Param, "|") {
+			lit, ok := literal(ft, param)
+			if !ok {
+				return false
+			}
+			lits = append(lits, lit)
+		}
+		g.generateIn(label, value, ft, rule.Name == "in", lits)
+	case ft.kind == kindString && rule.Name == "match":
+		name := g.addPattern(typeName+goName, rule.Param)
+		g.returnIfErr(label, fmt.Sprintf("%s.Matches(%s, false)", name, value))
+	case ft.kind == kindString && stringValidators[rule.Name] != "":
+		g.imports[checkImport] = true
+		g.returnIfErr(label, fmt.Sprintf("check.%s(%s, false)()", stringValidators[rule.Name], value))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// generateIn generates a switch statement, which only calls the validator
+// if the check fails. Duplicate literals (e.g. `in=1|1.0` for floats) are
+// left out of the switch cases, as they are not allowed by the compiler.
+// The arguments of the validator are converted to the type of the field,
+// as untyped constants would otherwise be passed as int or float64 values,
+// which cannot be compared to the value of the field.
+func (g *generator) generateIn(label, value string, ft fieldType, in bool, lits []string) {
+	g.imports[checkImport] = true
+
+	fn := "NotIn"
+	if in {
+		fn = "In"
+	}
+
+	args := make([]string, len(lits))
+	for i, lit := range lits {
+		args[i] = typedLiteral(ft, lit)
+	}
+	call := fmt.Sprintf("check.%s(%s, %s)()", fn, value, strings.Join(args, ", "))
+	if label != "" {
+		call = fmt.Sprintf("check.FieldError(%q, %s)", label, call)
+	}
+
+	var cases []string
+	seen := map[string]bool{}
+	for _, lit := range lits {
+		if !seen[lit] {
+			seen[lit] = true
+			cases = append(cases, lit)
+		}
+	}
+
+	g.printf("switch %s {\ncase %s:\n", value, strings.Join(cases, ", "))
+	if in {
+		g.printf("default:\n")
+	}
+	g.printf("return %s\n}\n", call)
+}
+
+func (g *generator) addPattern(name, expr string) string {
+	g.imports[checkfastImport] = true
+
+	name = "checkgenPattern" + name
+	if n := g.patternNames[name]; n > 0 {
+		g.patternNames[name]++
+		name += strconv.Itoa(n + 1)
+	} else {
+		g.patternNames[name] = 1
+	}
+
+	g.patterns = append(g.patterns, fmt.Sprintf("\t%s = checkfast.MustCompile(%s)\n", name, strconv.Quote(expr)))
+	return name
+}
+
+// literal returns the Go literal of the rule parameter, for values of the
+// specified type.
+func literal(ft fieldType, param string) (string, bool) {
+	switch ft.kind {
+	case kindString:
+		return strconv.Quote(param), true
+	case kindBool:
+		b, err := strconv.ParseBool(param)
+		return strconv.FormatBool(b), err == nil
+	case kindInt:
+		n, err := strconv.ParseInt(param, 10, ft.bits)
+		return strconv.FormatInt(n, 10), err == nil
+	case kindUint:
+		n, err := strconv.ParseUint(param, 10, ft.bits)
+		return strconv.FormatUint(n, 10), err == nil
+	case kindFloat:
+		f, err := strconv.ParseFloat(param, ft.bits)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", false
+		}
+		if f == 0 {
+			// Constants have no negative zero, so -0 and 0 are the same case.
+			f = 0
+		}
+		return strconv.FormatFloat(f, 'g', -1, ft.bits), true
+	}
+
+	return "", false
+}
+
+// typedLiteral converts the numeric literal lit to the type of the field,
+// unless it already has the default type of untyped integer constants.
+func typedLiteral(ft fieldType, lit string) string {
+	switch {
+	case ft.kind == kindInt && ft.name == "int":
+		return lit
+	case ft.kind == kindInt, ft.kind == kindUint, ft.kind == kindFloat:
+		return ft.name + "(" + lit + ")"
+	}
+
+	return lit
+}
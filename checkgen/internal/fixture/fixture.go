@@ -0,0 +1,29 @@
+// Package fixture contains struct types used to compare the Validate methods
+// generated by checkgen with check.Struct.
+package fixture
+
+//go:generate go run ../../cmd/checkgen -type Plan,Account -output fixture_check.go fixture.go
+
+// Plan contains numeric fields of types other than int, whose rules
+// are translated to direct calls.
+type Plan struct {
+	Rate  float64 `check:"not_in=1|2"`
+	Count uint    `check:"not_in=3"`
+	Level int8    `check:"in=1|2|3"`
+	Limit *uint16 `check:"in=10|20"`
+}
+
+// Account contains nested structs, some of which have no generated
+// Validate methods.
+type Account struct {
+	Name      string `check:"required"`
+	Plan      Plan
+	Addr      Address
+	Billing   *Address
+	Addresses []*Address
+}
+
+// Address has no generated Validate method.
+type Address struct {
+	City string `check:"required"`
+}
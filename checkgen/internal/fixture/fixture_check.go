@@ -0,0 +1,64 @@
+// Code generated by checkgen. DO NOT EDIT.
+
+package fixture
+
+import (
+	"strconv"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkfast"
+)
+
+// Validate validates the fields of x, based on the rules declared in
+// their `check` struct tags.
+func (x *Plan) Validate() error {
+	switch x.Rate {
+	case 1, 2:
+		return check.FieldError("Rate", check.NotIn(x.Rate, float64(1), float64(2))())
+	}
+	switch x.Count {
+	case 3:
+		return check.FieldError("Count", check.NotIn(x.Count, uint(3))())
+	}
+	switch x.Level {
+	case 1, 2, 3:
+	default:
+		return check.FieldError("Level", check.In(x.Level, int8(1), int8(2), int8(3))())
+	}
+	if x.Limit != nil {
+		switch *x.Limit {
+		case 10, 20:
+		default:
+			return check.FieldError("Limit", check.In(*x.Limit, uint16(10), uint16(20))())
+		}
+	}
+	return nil
+}
+
+// Validate validates the fields of x, based on the rules declared in
+// their `check` struct tags.
+func (x *Account) Validate() error {
+	if err := checkfast.Required(x.Name); err != nil {
+		return check.FieldError("Name", err)
+	}
+	if err := x.Plan.Validate(); err != nil {
+		return check.FieldError("Plan", err)
+	}
+	if err := check.Struct(x.Addr); err != nil {
+		return check.FieldError("Addr", err)
+	}
+	if x.Billing != nil {
+		if err := check.Struct(x.Billing); err != nil {
+			return check.FieldError("Billing", err)
+		}
+	}
+	for i := range x.Addresses {
+		if x.Addresses[i] == nil {
+			continue
+		}
+		if err := check.Struct(x.Addresses[i]); err != nil {
+			return check.FieldError("Addresses["+strconv.Itoa(i)+"]", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package checkgrpc provides gRPC server interceptors which validate the
+// incoming messages, rejecting the invalid ones with the INVALID_ARGUMENT
+// status code.
+package checkgrpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/adrg/check"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validator is implemented by messages which validate themselves.
+type Validator interface {
+	Validate() error
+}
+
+// Validate validates the message m. If m implements Validator, its Validate
+// method is used. Otherwise, if m is a pointer to a struct, its fields are
+// validated based on the rules declared in their `check` struct tags (see
+// check.StructValidator), using the names of the `json` struct tags, which
+// match the JSON names of the fields of generated protobuf messages.
+// Other messages are considered valid.
+//
+// The returned error, if any, has the INVALID_ARGUMENT status code (see
+// Status).
+func Validate(m interface{}) error {
+	var err error
+	switch v := m.(type) {
+	case Validator:
+		err = v.Validate()
+	default:
+		rv := reflect.ValueOf(m)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+		err = (&check.StructValidator{NameTag: "json"}).Validate(m)
+	}
+	if err == nil {
+		return nil
+	}
+
+	return Status(err).Err()
+}
+
+// Status converts the error returned by a validation run to a status with
+// the INVALID_ARGUMENT code. The failed checks are described by a
+// google.rpc.BadRequest detail, containing a field violation for each of
+// them. Errors of type check.Errors produce a violation for each of the
+// contained errors.
+func Status(err error) *status.Status {
+	st := status.New(codes.InvalidArgument, err.Error())
+
+	br := &errdetails.BadRequest{}
+	for _, err := range flattenErrors(err) {
+		var e *check.Error
+		if !errors.As(err, &e) {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Description: err.Error(),
+			})
+			continue
+		}
+
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       e.Field,
+			Description: e.Message,
+		})
+	}
+
+	if dst, derr := st.WithDetails(br); derr == nil {
+		st = dst
+	}
+
+	return st
+}
+
+func flattenErrors(err error) []error {
+	errs, ok := err.(check.Errors)
+	if !ok {
+		return []error{err}
+	}
+
+	var flat []error
+	for _, err := range errs {
+		flat = append(flat, flattenErrors(err)...)
+	}
+
+	return flat
+}
+
+// UnaryServerInterceptor returns a unary server interceptor which validates
+// the incoming requests (see Validate). Invalid requests are rejected
+// without calling the handler.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a stream server interceptor which
+// validates the messages received by the handlers (see Validate).
+// Receiving an invalid message returns an error, which the handlers
+// can return in order to end the stream.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return Validate(m)
+}
@@ -0,0 +1,50 @@
+package checkgrpc_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adrg/check/checkgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type CreateUserRequest struct {
+	Username string `json:"username,omitempty" check:"required,alphanumeric,min_len=3"`
+	Email    string `json:"email,omitempty" check:"required,email"`
+}
+
+func ExampleUnaryServerInterceptor() {
+	interceptor := checkgrpc.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "created " + req.(*CreateUserRequest).Username, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/users.v1.Users/CreateUser"}
+
+	for _, req := range []*CreateUserRequest{
+		{Username: "johndoe", Email: "john.doe@example.com"},
+		{Username: "johndoe", Email: "john.doe"},
+	} {
+		resp, err := interceptor(context.Background(), req, info, handler)
+		if err != nil {
+			// Treat error.
+			st := status.Convert(err)
+			fmt.Println(st.Code(), st.Message())
+			for _, detail := range st.Details() {
+				if br, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						fmt.Printf("%s: %s\n", v.GetField(), v.GetDescription())
+					}
+				}
+			}
+			continue
+		}
+		fmt.Println(resp)
+	}
+
+	// Output:
+	// created johndoe
+	// InvalidArgument email: invalid email address `john.doe`
+	// email: invalid email address `john.doe`
+}
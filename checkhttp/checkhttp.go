@@ -0,0 +1,270 @@
+// Package checkhttp decodes and validates HTTP request bodies, based on the
+// rules declared in the `check` struct tags of the destination types, and
+// validates request parameters, such as query parameters and headers.
+package checkhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/adrg/check"
+)
+
+// DecodeError is returned by Decode if the body of a request cannot be
+// decoded.
+type DecodeError struct {
+	Err error
+}
+
+// Error returns the message of the error.
+func (e *DecodeError) Error() string {
+	return "invalid request body: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DefaultMaxBodySize is the maximum size of the request bodies read by
+// Decode, in bytes.
+const DefaultMaxBodySize = 10 << 20
+
+// Decode decodes the body of the request into dst, which must be a pointer
+// to a struct, and validates the result (see check.Struct). JSON bodies are
+// decoded using encoding/json and the fields of the errors use the names
+// of the `json` struct tags. URL-encoded and multipart form bodies, as well
+// as requests without a body, such as GET requests, are decoded from the
+// form values of the request, which are assigned to the fields with the
+// same name in the `form` struct tags (or Go name, if missing). Form values
+// can be assigned to string, boolean and numeric fields, and to slices
+// of them. At most DefaultMaxBodySize bytes of the body are read. Use a
+// Decoder in order to change the limit.
+//
+// Returns a *DecodeError if the body cannot be decoded, or the validation
+// error otherwise.
+func Decode(r *http.Request, dst interface{}) error {
+	return (&Decoder{}).Decode(r, dst)
+}
+
+// Decoder decodes and validates request bodies (see Decode).
+type Decoder struct {
+	// MaxBodySize is the maximum size of the request bodies, in bytes.
+	// Larger bodies produce a *DecodeError wrapping an *http.MaxBytesError.
+	// If 0, DefaultMaxBodySize is used.
+	MaxBodySize int64
+}
+
+// Decode decodes the body of the request into dst, which must be a pointer
+// to a struct, and validates the result (see Decode).
+func (d *Decoder) Decode(r *http.Request, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("checkhttp: cannot decode into %T", dst)
+	}
+
+	maxSize := d.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBodySize
+	}
+	if r.Body != nil {
+		r.Body = http.MaxBytesReader(nil, r.Body, maxSize)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+			return &DecodeError{Err: err}
+		}
+		return (&check.StructValidator{NameTag: "json"}).Validate(dst)
+	case mediaType == "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return &DecodeError{Err: err}
+		}
+	case mediaType == "application/x-www-form-urlencoded" || mediaType == "":
+		if err := r.ParseForm(); err != nil {
+			return &DecodeError{Err: err}
+		}
+	default:
+		return &DecodeError{Err: fmt.Errorf("unsupported media type `%s`", mediaType)}
+	}
+
+	if err := decodeForm(r.Form, rv.Elem()); err != nil {
+		return &DecodeError{Err: err}
+	}
+
+	return (&check.StructValidator{NameTag: "form"}).Validate(dst)
+}
+
+func decodeForm(values url.Values, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("form"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		vals, ok := values[name]
+		if !ok || len(vals) == 0 {
+			continue
+		}
+		if err := setField(v.Field(i), vals); err != nil {
+			return fmt.Errorf("invalid value for field `%s`: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func setField(v reflect.Value, vals []string) error {
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(v.Type().Elem())
+		if err := setField(ptr.Elem(), vals); err != nil {
+			return err
+		}
+		v.Set(ptr)
+		return nil
+	}
+	if v.Kind() == reflect.Slice {
+		s := reflect.MakeSlice(v.Type(), len(vals), len(vals))
+		for i, val := range vals {
+			if err := setValue(s.Index(i), val); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+
+	return setValue(v, vals[0])
+}
+
+func setValue(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("cannot assign form value to type %v", v.Type())
+	}
+
+	return nil
+}
+
+type bodyKey struct{}
+
+// Middleware returns a middleware which decodes and validates the body of
+// the requests into a new value of type T, which must be a struct type,
+// before calling next. The value can be retrieved by the handlers using
+// Body. If the body cannot be decoded or is not valid, an error response
+// is written using WriteError and next is not called. Middleware panics
+// if T is not a struct type.
+func Middleware[T any](next http.Handler) http.Handler {
+	if t := reflect.TypeOf((*T)(nil)).Elem(); t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("checkhttp: cannot decode into %v", t))
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(T)
+		if err := Decode(r, v); err != nil {
+			WriteError(w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), bodyKey{}, v)))
+	})
+}
+
+// Body returns the body decoded by Middleware for the request, or nil if
+// the request has no decoded body of type T.
+func Body[T any](r *http.Request) *T {
+	v, _ := r.Context().Value(bodyKey{}).(*T)
+	return v
+}
+
+// WriteError writes a problem details document (see check.Problem) for the
+// error returned by Decode to w. Decoding errors produce documents with the
+// 400 (Bad Request) status, or the 413 (Content Too Large) status if the
+// body exceeds the maximum size, and validation errors produce documents
+// with the 422 (Unprocessable Content) status, listing the failed checks.
+// Any other errors produce documents with the 500 (Internal Server Error)
+// status, which do not include the message of the error.
+func WriteError(w http.ResponseWriter, err error) error {
+	var de *DecodeError
+	if errors.As(err, &de) {
+		status := http.StatusBadRequest
+
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		return writeProblem(w, &check.Problem{
+			Title:  http.StatusText(status),
+			Status: status,
+			Detail: de.Error(),
+		})
+	}
+
+	var checkErr *check.Error
+	var checkErrs check.Errors
+	if errors.As(err, &checkErr) || errors.As(err, &checkErrs) {
+		return check.WriteProblem(w, err)
+	}
+
+	return writeProblem(w, &check.Problem{
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+	})
+}
+
+func writeProblem(w http.ResponseWriter, p *check.Problem) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", check.ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,124 @@
+package checkhttp_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/adrg/check"
+	"github.com/adrg/check/checkhttp"
+)
+
+type SignupRequest struct {
+	Username string `json:"username" form:"username" check:"required,alphanumeric,min_len=3"`
+	Email    string `json:"email" form:"email" check:"required,email"`
+	Age      int    `json:"age" form:"age" check:"gte=18"`
+}
+
+func ExampleDecode() {
+	r := httptest.NewRequest(http.MethodPost, "/signup",
+		strings.NewReader(`{"username": "johndoe", "email": "john.doe", "age": 30}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	var req SignupRequest
+	if err := checkhttp.Decode(r, &req); err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/signup",
+		strings.NewReader("username=johndoe&email=john.doe@example.com&age=16"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if err := checkhttp.Decode(r, &req); err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+
+	// Output:
+	// email: invalid email address `john.doe`
+	// age: `gte` comparison failed: `16` is not greater than or equal to `18`
+}
+
+func ExampleMiddleware() {
+	handler := checkhttp.Middleware[SignupRequest](http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := checkhttp.Body[SignupRequest](r)
+		fmt.Fprintf(w, "welcome, %s", req.Username)
+	}))
+
+	for _, body := range []string{
+		`{"username": "johndoe", "email": "john.doe@example.com", "age": 30}`,
+		`{"username": "jd", "email": "john.doe@example.com", "age": 30}`,
+		`{"username": "johndoe",`,
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		fmt.Println(w.Code, w.Body.String())
+	}
+
+	// Output:
+	// 200 welcome, johndoe
+	// 422 {"title":"Unprocessable Entity","status":422,"detail":"username: length of `jd` is `2`, less than `3`","errors":[{"field":"username","code":"min_len","detail":"length of `jd` is `2`, less than `3`","params":{"min":3}}]}
+	// 400 {"title":"Bad Request","status":400,"detail":"invalid request body: unexpected EOF"}
+}
+
+func ExampleDecoder() {
+	decoder := &checkhttp.Decoder{MaxBodySize: 32}
+
+	r := httptest.NewRequest(http.MethodPost, "/signup",
+		strings.NewReader(`{"username": "johndoe", "email": "john.doe@example.com", "age": 30}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	var req SignupRequest
+	if err := decoder.Decode(r, &req); err != nil {
+		// Treat error.
+		w := httptest.NewRecorder()
+		checkhttp.WriteError(w, err)
+		fmt.Println(w.Code, w.Body.String())
+	}
+
+	// Output:
+	// 413 {"title":"Request Entity Too Large","status":413,"detail":"invalid request body: http: request body too large"}
+}
+
+func ExampleWriteError() {
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	// Decoding into a non-pointer value is a programming error.
+	var req SignupRequest
+	if err := checkhttp.Decode(r, req); err != nil {
+		// Treat error.
+		w := httptest.NewRecorder()
+		checkhttp.WriteError(w, err)
+		fmt.Println(w.Code, w.Body.String())
+	}
+
+	// Output:
+	// 500 {"title":"Internal Server Error","status":500}
+}
+
+func ExampleQuery() {
+	r := httptest.NewRequest(http.MethodGet, "/users?page=0&limit=abc&sort=name&active=true", nil)
+
+	q := checkhttp.Query(r.URL.Query())
+	page := q.Int("page", 1, check.Rules("gte=1"))
+	limit := q.Int("limit", 20, check.Rules("lte=100"))
+	sort := q.String("sort", "date", check.Rules("in=name|date"))
+	active := q.Bool("active", false)
+	q.String("token", "", check.Rules("required"))
+
+	fmt.Println(page, limit, sort, active)
+	if err := q.Err(); err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+
+	// Output:
+	// 1 20 name true
+	// page: `gte` comparison failed: `0` is not greater than or equal to `1`; limit: `abc` is not a valid integer; token: empty argument
+}
@@ -0,0 +1,194 @@
+package checkhttp
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/adrg/check"
+)
+
+// Params reads and validates the parameters of a request (e.g. query
+// parameters, headers or path parameters), converting them to the types
+// expected by the handlers. The errors of all the parameters are collected
+// and returned by Err, so that they can be reported at once:
+//
+//	q := checkhttp.Query(r.URL.Query())
+//	page := q.Int("page", 1, check.Rules("gte=1"))
+//	sort := q.String("sort", "name", check.Rules("in=name|date"))
+//	if err := q.Err(); err != nil {
+//		checkhttp.WriteError(w, err)
+//		return
+//	}
+//
+// The checks of a parameter receive its converted value or nil, if the
+// parameter is missing. Params are not safe for concurrent use.
+type Params struct {
+	get  func(name string) (string, bool)
+	errs check.Errors
+}
+
+// Query returns the parameters of the specified query values.
+func Query(values url.Values) *Params {
+	return &Params{get: func(name string) (string, bool) {
+		vals, ok := values[name]
+		if !ok || len(vals) == 0 {
+			return "", false
+		}
+		return vals[0], true
+	}}
+}
+
+// Header returns the parameters of the specified header. Parameter names
+// are canonicalized (see http.CanonicalHeaderKey).
+func Header(h http.Header) *Params {
+	return &Params{get: func(name string) (string, bool) {
+		vals := h.Values(name)
+		if len(vals) == 0 {
+			return "", false
+		}
+		return vals[0], true
+	}}
+}
+
+// Path returns the parameters obtained using the specified function, which
+// returns the value of a path parameter by name (e.g. http.Request.PathValue
+// or the equivalent of a router). Empty values are treated as missing.
+func Path(get func(name string) string) *Params {
+	return &Params{get: func(name string) (string, bool) {
+		val := get(name)
+		return val, val != ""
+	}}
+}
+
+// Err returns the errors of the parameters read so far, as check.Errors,
+// or nil if all of them are valid.
+func (p *Params) Err() error {
+	if len(p.errs) == 0 {
+		return nil
+	}
+
+	return p.errs
+}
+
+func (p *Params) check(name string, x interface{}, fns []check.ValueFunc) bool {
+	vfs := make([]check.ValidateFunc, len(fns))
+	for i, fn := range fns {
+		vfs[i] = fn(x)
+	}
+
+	if err := check.Field(name, vfs...)(); err != nil {
+		p.errs = append(p.errs, err)
+		return false
+	}
+
+	return true
+}
+
+func (p *Params) invalid(name, val, desc string) {
+	p.errs = append(p.errs, &check.Error{
+		Code:    check.CodeInvalid,
+		Field:   name,
+		Value:   val,
+		Message: "`" + val + "` is not a valid " + desc,
+	})
+}
+
+// String returns the value of the parameter with the specified name, or
+// def if the parameter is missing or invalid.
+func (p *Params) String(name, def string, fns ...check.ValueFunc) string {
+	val, ok := p.get(name)
+	if !ok {
+		p.check(name, nil, fns)
+		return def
+	}
+	if !p.check(name, val, fns) {
+		return def
+	}
+
+	return val
+}
+
+// Int returns the value of the parameter with the specified name, as an
+// integer, or def if the parameter is missing or invalid.
+func (p *Params) Int(name string, def int, fns ...check.ValueFunc) int {
+	val, ok := p.get(name)
+	if !ok {
+		p.check(name, nil, fns)
+		return def
+	}
+
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		p.invalid(name, val, "integer")
+		return def
+	}
+	if !p.check(name, n, fns) {
+		return def
+	}
+
+	return n
+}
+
+// Float returns the value of the parameter with the specified name, as a
+// floating-point number, or def if the parameter is missing or invalid.
+func (p *Params) Float(name string, def float64, fns ...check.ValueFunc) float64 {
+	val, ok := p.get(name)
+	if !ok {
+		p.check(name, nil, fns)
+		return def
+	}
+
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		p.invalid(name, val, "number")
+		return def
+	}
+	if !p.check(name, f, fns) {
+		return def
+	}
+
+	return f
+}
+
+// Bool returns the value of the parameter with the specified name, as a
+// boolean, or def if the parameter is missing or invalid. The accepted
+// values are the ones accepted by strconv.ParseBool.
+func (p *Params) Bool(name string, def bool, fns ...check.ValueFunc) bool {
+	val, ok := p.get(name)
+	if !ok {
+		p.check(name, nil, fns)
+		return def
+	}
+
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		p.invalid(name, val, "boolean")
+		return def
+	}
+	if !p.check(name, b, fns) {
+		return def
+	}
+
+	return b
+}
+
+// UUID returns the value of the parameter with the specified name, if it is
+// a valid UUID (see check.UUID). Returns an empty string if the parameter is
+// missing or invalid.
+func (p *Params) UUID(name string, fns ...check.ValueFunc) string {
+	val, ok := p.get(name)
+	if !ok {
+		p.check(name, nil, fns)
+		return ""
+	}
+	if err := check.Field(name, check.UUID(val, true))(); err != nil {
+		p.errs = append(p.errs, err)
+		return ""
+	}
+	if !p.check(name, val, fns) {
+		return ""
+	}
+
+	return val
+}
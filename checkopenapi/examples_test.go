@@ -0,0 +1,152 @@
+package checkopenapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/adrg/check/checkopenapi"
+)
+
+func ExampleSchemaFor() {
+	type User struct {
+		ID       string   `json:"id" check:"required,uuid"`
+		Username string   `json:"username" check:"required,min_len=3,max_len=32,match=^[a-z0-9_]+$"`
+		Email    string   `json:"email" check:"required,email"`
+		Age      *int     `json:"age" check:"gte=18,lt=130"`
+		Role     string   `json:"role" check:"in=admin|user"`
+		Tags     []string `json:"tags" check:"max_len=5"`
+		Nickname string   `json:"nickname" check:"alpha"`
+	}
+
+	schema, err := checkopenapi.SchemaFor[User]()
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+	fmt.Println(string(data))
+
+	// Output:
+	// {
+	//   "type": "object",
+	//   "properties": {
+	//     "age": {
+	//       "type": "integer",
+	//       "format": "int64",
+	//       "nullable": true,
+	//       "minimum": 18,
+	//       "maximum": 130,
+	//       "exclusiveMaximum": true
+	//     },
+	//     "email": {
+	//       "type": "string",
+	//       "format": "email",
+	//       "minLength": 1
+	//     },
+	//     "id": {
+	//       "type": "string",
+	//       "format": "uuid",
+	//       "minLength": 1
+	//     },
+	//     "nickname": {
+	//       "type": "string",
+	//       "x-check-rules": [
+	//         "alpha"
+	//       ]
+	//     },
+	//     "role": {
+	//       "type": "string",
+	//       "enum": [
+	//         "admin",
+	//         "user"
+	//       ]
+	//     },
+	//     "tags": {
+	//       "type": "array",
+	//       "maxItems": 5,
+	//       "items": {
+	//         "type": "string"
+	//       }
+	//     },
+	//     "username": {
+	//       "type": "string",
+	//       "pattern": "^[a-z0-9_]+$",
+	//       "minLength": 3,
+	//       "maxLength": 32
+	//     }
+	//   },
+	//   "required": [
+	//     "id",
+	//     "username",
+	//     "email"
+	//   ]
+	// }
+}
+
+func ExampleComponentsFor() {
+	type Category struct {
+		Name     string      `json:"name" check:"required"`
+		Parent   *Category   `json:"parent"`
+		Children []*Category `json:"children" check:"max_len=10"`
+	}
+
+	// The schemas of recursive types cannot be included in place.
+	if _, err := checkopenapi.SchemaFor[Category](); err != nil {
+		fmt.Println(err)
+	}
+
+	components, err := checkopenapi.ComponentsFor[Category]()
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+
+	data, err := json.MarshalIndent(components, "", "  ")
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+	fmt.Println(string(data))
+
+	// Output:
+	// checkopenapi: field Parent: recursive type `checkopenapi_test.Category` is not supported
+	// {
+	//   "Category": {
+	//     "type": "object",
+	//     "properties": {
+	//       "children": {
+	//         "type": "array",
+	//         "maxItems": 10,
+	//         "items": {
+	//           "nullable": true,
+	//           "allOf": [
+	//             {
+	//               "$ref": "#/components/schemas/Category"
+	//             }
+	//           ]
+	//         }
+	//       },
+	//       "name": {
+	//         "type": "string",
+	//         "minLength": 1
+	//       },
+	//       "parent": {
+	//         "nullable": true,
+	//         "allOf": [
+	//           {
+	//             "$ref": "#/components/schemas/Category"
+	//           }
+	//         ]
+	//       }
+	//     },
+	//     "required": [
+	//       "name"
+	//     ]
+	//   }
+	// }
+}
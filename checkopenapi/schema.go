@@ -0,0 +1,391 @@
+// Package checkopenapi generates OpenAPI 3 schemas from the rules declared
+// in the `check` struct tags of Go types, so that API documentation can
+// reflect server-side validation.
+package checkopenapi
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrg/check"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Schema represents an OpenAPI 3 schema object. Only the keywords which can
+// be derived from Go types and check rules are included.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     bool               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool               `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           *float64           `json:"multipleOf,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+
+	// Rules contains the rules which cannot be expressed using schema
+	// keywords. It is encoded as the `x-check-rules` extension.
+	Rules []string `json:"x-check-rules,omitempty"`
+}
+
+// SchemaFor returns the schema of the struct type T (or pointer to struct).
+// Property names are read from the `json` struct tags of the fields. Fields
+// with the `required` rule are listed as required properties and pointer
+// fields are nullable, unless marked as `required`. Rules which cannot be
+// expressed using schema keywords are listed in the Rules of the schemas.
+// The schemas of nested structs are included in place, which means that
+// recursive types (e.g. trees or linked lists) are not supported by
+// SchemaFor. Use ComponentsFor in order to generate their schemas.
+func SchemaFor[T any]() (*Schema, error) {
+	t, err := structType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	return (&generator{visiting: map[reflect.Type]bool{}}).schemaOf(t, nil)
+}
+
+// ComponentsFor returns the schemas of the struct type T (or pointer to
+// struct) and of the named struct types it references, indexed by type
+// name, to be added to the `components/schemas` section of an OpenAPI
+// document. The schemas reference each other using the `$ref` keyword
+// (e.g. `#/components/schemas/Node`), so recursive types are supported.
+// Anonymous struct types are included in place. The schemas are generated
+// in the same way as by SchemaFor.
+func ComponentsFor[T any]() (map[string]*Schema, error) {
+	t, err := structType[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		visiting:   map[reflect.Type]bool{},
+		components: map[string]*Schema{},
+		names:      map[string]reflect.Type{},
+	}
+	if _, err := g.schemaOf(t, nil); err != nil {
+		return nil, err
+	}
+
+	return g.components, nil
+}
+
+// ComponentRef is the prefix of the references to the schemas returned by
+// ComponentsFor.
+const ComponentRef = "#/components/schemas/"
+
+func structType[T any]() (reflect.Type, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil, fmt.Errorf("checkopenapi: cannot generate schema for `%v`", t)
+	}
+
+	return t, nil
+}
+
+// generator generates the schemas of Go types.
+type generator struct {
+	// visiting contains the struct types whose schemas are being generated,
+	// in order to detect recursive types.
+	visiting map[reflect.Type]bool
+
+	// components contains the schemas of the named struct types, by name.
+	// If nil, the schemas of all struct types are included in place.
+	components map[string]*Schema
+	names      map[string]reflect.Type
+}
+
+func (g *generator) structSchema(t reflect.Type) (*Schema, error) {
+	if g.components == nil || t.Name() == "" {
+		if g.visiting[t] {
+			return nil, fmt.Errorf("recursive type `%v` is not supported", t)
+		}
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+
+		return g.objectSchema(t)
+	}
+
+	name := componentName(t)
+	if nt, ok := g.names[name]; ok {
+		if nt != t {
+			return nil, fmt.Errorf("types `%v` and `%v` have the same component name `%s`", nt, t, name)
+		}
+		return &Schema{Ref: ComponentRef + name}, nil
+	}
+
+	// Register the name before generating the schema, so that recursive
+	// references to the type resolve to the component.
+	g.names[name] = t
+	s, err := g.objectSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	g.components[name] = s
+
+	return &Schema{Ref: ComponentRef + name}, nil
+}
+
+// componentName returns the name of the component of the named type t,
+// replacing the characters which are not allowed in component names
+// (e.g. the brackets of generic types).
+func componentName(t reflect.Type) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') ||
+			r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, t.Name())
+}
+
+func (g *generator) objectSchema(t reflect.Type) (*Schema, error) {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	if err := g.addProperties(s, t); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (g *generator) addProperties(s *Schema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" || sf.Tag.Get("check") == "-" {
+			continue
+		}
+
+		ft := sf.Type
+		if sf.Anonymous && name == "" {
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if g.visiting[ft] {
+					return fmt.Errorf("checkopenapi: recursive embedded type `%v` is not supported", ft)
+				}
+				g.visiting[ft] = true
+				err := g.addProperties(s, ft)
+				delete(g.visiting, ft)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		rules, err := check.ParseTag(sf.Tag.Get("check"))
+		if err != nil {
+			return err
+		}
+		prop, err := g.schemaOf(sf.Type, rules)
+		if err != nil {
+			return fmt.Errorf("checkopenapi: field %s: %w", sf.Name, err)
+		}
+
+		s.Properties[name] = prop
+		if hasRule(rules, "required") {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) schemaOf(t reflect.Type, rules []check.Rule) (*Schema, error) {
+	if t.Kind() == reflect.Ptr {
+		s, err := g.schemaOf(t.Elem(), rules)
+		if err != nil {
+			return nil, err
+		}
+		if nullable := !hasRule(rules, "required"); nullable && s.Ref != "" {
+			// The keywords next to references are ignored.
+			s = &Schema{Nullable: true, AllOf: []*Schema{s}}
+		} else {
+			s.Nullable = nullable
+		}
+		return s, nil
+	}
+
+	s := &Schema{}
+	switch t.Kind() {
+	case reflect.String:
+		s.Type = "string"
+	case reflect.Bool:
+		s.Type = "boolean"
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		s.Type, s.Format = "integer", "int32"
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		s.Type, s.Format = "integer", "int64"
+	case reflect.Float32:
+		s.Type, s.Format = "number", "float"
+	case reflect.Float64:
+		s.Type, s.Format = "number", "double"
+	case reflect.Slice, reflect.Array:
+		items, err := g.schemaOf(t.Elem(), nil)
+		if err != nil {
+			return nil, err
+		}
+		s.Type, s.Items = "array", items
+	case reflect.Map:
+		values, err := g.schemaOf(t.Elem(), nil)
+		if err != nil {
+			return nil, err
+		}
+		s.Type, s.AdditionalProperties = "object", values
+	case reflect.Struct:
+		if t == timeType {
+			s.Type, s.Format = "string", "date-time"
+			break
+		}
+		return g.structSchema(t)
+	case reflect.Interface:
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported type `%v`", t)
+	}
+	if t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64 {
+		s.Minimum = floatPtr(0)
+	}
+
+	for _, rule := range rules {
+		if !applyRule(s, t.Kind(), rule) {
+			name := rule.Name
+			if rule.Param != "" {
+				name += "=" + rule.Param
+			}
+			s.Rules = append(s.Rules, name)
+		}
+	}
+
+	return s, nil
+}
+
+// applyRule sets the schema keywords which correspond to the rule, for
+// values of the specified kind. Returns false if the rule cannot be
+// expressed using schema keywords.
+func applyRule(s *Schema, kind reflect.Kind, rule check.Rule) bool {
+	sized := kind == reflect.String || kind == reflect.Slice || kind == reflect.Array
+	numeric := kind >= reflect.Int && kind <= reflect.Float64
+	n, nerr := strconv.Atoi(rule.Param)
+	f, ferr := strconv.ParseFloat(rule.Param, 64)
+
+	switch {
+	case rule.Name == "required":
+		if kind == reflect.String {
+			s.MinLength = maxInt(s.MinLength, 1)
+		} else if sized {
+			s.MinItems = maxInt(s.MinItems, 1)
+		}
+	case sized && nerr == nil && (rule.Name == "len" || rule.Name == "min_len" || rule.Name == "max_len"):
+		lower, upper := &s.MinLength, &s.MaxLength
+		if kind != reflect.String {
+			lower, upper = &s.MinItems, &s.MaxItems
+		}
+		if rule.Name != "max_len" {
+			*lower = maxInt(*lower, n)
+		}
+		if rule.Name != "min_len" {
+			*upper = intPtr(n)
+		}
+	case numeric && ferr == nil && (rule.Name == "gt" || rule.Name == "gte"):
+		s.Minimum, s.ExclusiveMinimum = floatPtr(f), rule.Name == "gt"
+	case numeric && ferr == nil && (rule.Name == "lt" || rule.Name == "lte"):
+		s.Maximum, s.ExclusiveMaximum = floatPtr(f), rule.Name == "lt"
+	case numeric && (rule.Name == "positive" || rule.Name == "non_negative"):
+		s.Minimum, s.ExclusiveMinimum = floatPtr(0), rule.Name == "positive"
+	case numeric && rule.Name == "negative":
+		s.Maximum, s.ExclusiveMaximum = floatPtr(0), true
+	case numeric && ferr == nil && f > 0 && rule.Name == "multiple_of":
+		s.MultipleOf = floatPtr(f)
+	case sized && kind != reflect.String && rule.Name == "unique":
+		s.UniqueItems = true
+	case rule.Name == "in" && (kind == reflect.String || numeric):
+		var enum []interface{}
+		for _, elem := range strings.Split(rule.Param, "|") {
+			if kind == reflect.String {
+				enum = append(enum, elem)
+				continue
+			}
+			v, err := strconv.ParseFloat(elem, 64)
+			if err != nil {
+				return false
+			}
+			enum = append(enum, v)
+		}
+		s.Enum = enum
+	case kind == reflect.String && rule.Name == "match":
+		s.Pattern = rule.Param
+	case kind == reflect.String && stringFormats[rule.Name] != "":
+		s.Format = stringFormats[rule.Name]
+	default:
+		return false
+	}
+
+	return true
+}
+
+// stringFormats contains the schema formats which correspond to the string
+// format rules.
+var stringFormats = map[string]string{
+	"email":   "email",
+	"url":     "uri",
+	"uuid":    "uuid",
+	"date":    "date",
+	"rfc3339": "date-time",
+	"base64":  "byte",
+}
+
+func hasRule(rules []check.Rule, name string) bool {
+	for _, rule := range rules {
+		if rule.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func maxInt(p *int, n int) *int {
+	if p != nil && *p >= n {
+		return p
+	}
+
+	return intPtr(n)
+}
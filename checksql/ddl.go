@@ -0,0 +1,212 @@
+// Package checksql suggests SQL constraints based on the rules declared in
+// the `check` struct tags of Go types, so that database constraints can
+// reflect application validation.
+package checksql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/adrg/check"
+)
+
+// Dialect represents an SQL dialect.
+type Dialect int
+
+// Supported SQL dialects.
+const (
+	Postgres Dialect = iota
+	MySQL
+	SQLite
+)
+
+// Constraints returns a migration snippet containing the SQL constraints
+// suggested for the table storing values of the struct v (or pointer to
+// struct), in the specified dialect. Column names are read from the `db`
+// struct tags of the fields or, if missing, derived from their Go names
+// (e.g. `CreatedAt` becomes `created_at`). Fields tagged with
+// `checksql:"unique"` receive UNIQUE constraints. Nested structs, slices
+// and maps are skipped. Rules which cannot be expressed as constraints in
+// the dialect are added as comments. SQLite does not support adding
+// constraints to existing tables, so all suggestions are emitted as
+// comments for it.
+func Constraints(table string, v interface{}, dialect Dialect) (string, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", fmt.Errorf("checksql: cannot generate constraints for `%v`", t)
+	}
+
+	g := &generator{table: table, dialect: dialect}
+	fmt.Fprintf(&g.b, "-- Constraints suggested for table %s, based on its validation rules.\n", table)
+	if err := g.fields(t); err != nil {
+		return "", err
+	}
+
+	return g.b.String(), nil
+}
+
+type generator struct {
+	table   string
+	dialect Dialect
+	b       strings.Builder
+}
+
+func (g *generator) fields(t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		ft := sf.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && ft.Kind() == reflect.Struct {
+			if err := g.fields(ft); err != nil {
+				return err
+			}
+			continue
+		}
+
+		column, _, _ := strings.Cut(sf.Tag.Get("db"), ",")
+		if column == "-" || sf.Tag.Get("check") == "-" || !isColumnType(ft) {
+			continue
+		}
+		if column == "" {
+			column = snakeCase(sf.Name)
+		}
+
+		rules, err := check.ParseTag(sf.Tag.Get("check"))
+		if err != nil {
+			return fmt.Errorf("checksql: field %s: %w", sf.Name, err)
+		}
+		for _, rule := range rules {
+			g.rule(column, ft.Kind(), rule)
+		}
+		if sf.Tag.Get("checksql") == "unique" {
+			g.constraint(column+"_key", "UNIQUE ("+column+")")
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) rule(column string, kind reflect.Kind, rule check.Rule) {
+	isString := kind == reflect.String
+	isNumber := kind >= reflect.Int && kind <= reflect.Float64
+
+	length := "char_length(" + column + ")"
+	if g.dialect == SQLite {
+		length = "length(" + column + ")"
+	}
+
+	name := column + "_" + rule.Name
+	switch {
+	case rule.Name == "required":
+		g.notNull(column)
+		if isString {
+			g.constraint(name, "CHECK ("+column+" <> '')")
+		}
+	case isString && rule.Name == "len":
+		g.constraint(name, "CHECK ("+length+" = "+rule.Param+")")
+	case isString && rule.Name == "min_len":
+		g.constraint(name, "CHECK ("+length+" >= "+rule.Param+")")
+	case isString && rule.Name == "max_len":
+		g.constraint(name, "CHECK ("+length+" <= "+rule.Param+")")
+	case isNumber && isComparison(rule.Name):
+		g.constraint(name, "CHECK ("+column+" "+sqlOperators[rule.Name]+" "+rule.Param+")")
+	case (isString || isNumber) && (rule.Name == "in" || rule.Name == "not_in"):
+		values := strings.Split(rule.Param, "|")
+		for i, value := range values {
+			if isString {
+				values[i] = quoteString(value)
+			}
+		}
+
+		op := " IN "
+		if rule.Name == "not_in" {
+			op = " NOT IN "
+		}
+		g.constraint(name, "CHECK ("+column+op+"("+strings.Join(values, ", ")+"))")
+	case isString && rule.Name == "match" && g.dialect == Postgres:
+		g.constraint(name, "CHECK ("+column+" ~ "+quoteString(rule.Param)+")")
+	case isString && rule.Name == "match" && g.dialect == MySQL:
+		g.constraint(name, "CHECK (REGEXP_LIKE("+column+", "+quoteString(rule.Param)+"))")
+	default:
+		desc := rule.Name
+		if rule.Param != "" {
+			desc += "=" + rule.Param
+		}
+		fmt.Fprintf(&g.b, "-- %s: %s (not enforced by the database)\n", column, desc)
+	}
+}
+
+func (g *generator) notNull(column string) {
+	switch g.dialect {
+	case Postgres:
+		fmt.Fprintf(&g.b, "ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;\n",
+			g.table, column)
+	default:
+		fmt.Fprintf(&g.b, "-- %s: NOT NULL\n", column)
+	}
+}
+
+func (g *generator) constraint(name, def string) {
+	stmt := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s;",
+		g.table, g.table+"_"+name, def)
+	if g.dialect == SQLite {
+		stmt = "-- " + stmt
+	}
+
+	g.b.WriteString(stmt + "\n")
+}
+
+var sqlOperators = map[string]string{
+	"eq": "=", "ne": "<>", "lt": "<", "lte": "<=", "gt": ">", "gte": ">=",
+}
+
+func isComparison(name string) bool {
+	_, ok := sqlOperators[name]
+	return ok
+}
+
+func isColumnType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct:
+		return t.PkgPath() == "time" && t.Name() == "Time"
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	case reflect.Array, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return false
+	}
+
+	return true
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func snakeCase(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
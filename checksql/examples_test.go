@@ -0,0 +1,39 @@
+package checksql_test
+
+import (
+	"fmt"
+
+	"github.com/adrg/check/checksql"
+)
+
+func ExampleConstraints() {
+	type User struct {
+		ID       string `db:"id" checksql:"unique"`
+		Username string `check:"required,min_len=3,max_len=32,match=^[a-z0-9_]+$"`
+		Email    string `check:"required,email" checksql:"unique"`
+		Age      int    `check:"gte=18"`
+		Role     string `check:"in=admin|user"`
+	}
+
+	ddl, err := checksql.Constraints("users", User{}, checksql.Postgres)
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+	fmt.Print(ddl)
+
+	// Output:
+	// -- Constraints suggested for table users, based on its validation rules.
+	// ALTER TABLE users ADD CONSTRAINT users_id_key UNIQUE (id);
+	// ALTER TABLE users ALTER COLUMN username SET NOT NULL;
+	// ALTER TABLE users ADD CONSTRAINT users_username_required CHECK (username <> '');
+	// ALTER TABLE users ADD CONSTRAINT users_username_min_len CHECK (char_length(username) >= 3);
+	// ALTER TABLE users ADD CONSTRAINT users_username_max_len CHECK (char_length(username) <= 32);
+	// ALTER TABLE users ADD CONSTRAINT users_username_match CHECK (username ~ '^[a-z0-9_]+$');
+	// ALTER TABLE users ALTER COLUMN email SET NOT NULL;
+	// ALTER TABLE users ADD CONSTRAINT users_email_required CHECK (email <> '');
+	// -- email: email (not enforced by the database)
+	// ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+	// ALTER TABLE users ADD CONSTRAINT users_age_gte CHECK (age >= 18);
+	// ALTER TABLE users ADD CONSTRAINT users_role_in CHECK (role IN ('admin', 'user'));
+}
@@ -0,0 +1,81 @@
+package checkts_test
+
+import (
+	"fmt"
+
+	"github.com/adrg/check/checkts"
+)
+
+func ExampleZod() {
+	type Address struct {
+		Street  string `json:"street" check:"required"`
+		Country string `json:"country" check:"required,len=2,country"`
+	}
+
+	type User struct {
+		Name    string   `json:"name" check:"required,max_len=64"`
+		Email   string   `json:"email" check:"required,email"`
+		Age     int      `json:"age" check:"gte=18,lt=130"`
+		Role    string   `json:"role" check:"in=admin|user"`
+		Tags    []string `json:"tags,omitempty" check:"max_len=5"`
+		Address *Address `json:"address"`
+	}
+
+	ts, err := checkts.Zod("User", User{})
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+	fmt.Print(ts)
+
+	// Output:
+	// export const UserSchema = z.object({
+	//   "name": z.string().min(1).max(64),
+	//   "email": z.string().min(1).email(),
+	//   "age": z.number().int().gte(18).lt(130),
+	//   "role": z.enum(["admin","user"]),
+	//   "tags": z.array(z.string()).max(5).optional(),
+	//   "address": z.object({
+	//     "street": z.string().min(1),
+	//     "country": z.string().min(1).length(2), // check: country
+	//   }).nullable(),
+	// });
+	// export type User = z.infer<typeof UserSchema>;
+}
+
+func ExampleZod_recursive() {
+	type Category struct {
+		Name   string    `json:"name" check:"required"`
+		Parent *Category `json:"parent"`
+	}
+
+	// The types of recursive Zod schemas cannot be inferred.
+	if _, err := checkts.Zod("Category", Category{}); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// checkts: field Parent: recursive type `checkts_test.Category` is not supported
+}
+
+func ExampleZod_invalidParams() {
+	type Item struct {
+		Count int    `json:"count" check:"gte=1e3,lt=1);alert(1"`
+		Name  string `json:"name" check:"max_len=-1"`
+	}
+
+	// Rules with invalid parameters are added as comments.
+	ts, err := checkts.Zod("Item", Item{})
+	if err != nil {
+		// Treat error.
+		fmt.Println(err)
+	}
+	fmt.Print(ts)
+
+	// Output:
+	// export const ItemSchema = z.object({
+	//   "count": z.number().int().gte(1000), // check: lt=1);alert(1
+	//   "name": z.string(), // check: max_len=-1
+	// });
+	// export type Item = z.infer<typeof ItemSchema>;
+}
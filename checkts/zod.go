@@ -0,0 +1,323 @@
+// Package checkts generates TypeScript validation schemas from the rules
+// declared in the `check` struct tags of Go types, so that frontend and
+// backend validation can share a single source of truth.
+package checkts
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adrg/check"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Zod returns the TypeScript declarations of a Zod schema, named after the
+// name parameter, and of its inferred type, for the struct v (or pointer to
+// struct). Object keys are read from the `json` struct tags of the fields.
+// Fields with the `omitempty` option are optional and pointer fields are
+// nullable, unless marked as `required`. Rules which cannot be expressed
+// using Zod are added as comments. The declarations expect `z` to be
+// imported from the `zod` module. Recursive types (e.g. trees or linked
+// lists) are not supported, as the types of recursive Zod schemas cannot
+// be inferred.
+func Zod(name string, v interface{}) (string, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", fmt.Errorf("checkts: cannot generate schema for `%v`", t)
+	}
+
+	g := &generator{visiting: map[reflect.Type]bool{}}
+	schema, err := g.zodType(t, nil, "")
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "export const %sSchema = %s;\n", name, schema)
+	fmt.Fprintf(&b, "export type %s = z.infer<typeof %sSchema>;\n", name, name)
+	return b.String(), nil
+}
+
+// generator generates the Zod schemas of Go types.
+type generator struct {
+	// visiting contains the struct types whose schemas are being generated,
+	// in order to detect recursive types.
+	visiting map[reflect.Type]bool
+}
+
+func (g *generator) zodObject(t reflect.Type, indent string) (string, error) {
+	if g.visiting[t] {
+		return "", fmt.Errorf("recursive type `%v` is not supported", t)
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	var b strings.Builder
+	b.WriteString("z.object({\n")
+	if err := g.writeFields(&b, t, indent+"  "); err != nil {
+		return "", err
+	}
+	b.WriteString(indent + "})")
+
+	return b.String(), nil
+}
+
+func (g *generator) writeFields(b *strings.Builder, t reflect.Type, indent string) error {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" || sf.Tag.Get("check") == "-" {
+			continue
+		}
+
+		ft := sf.Type
+		if sf.Anonymous && name == "" {
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if g.visiting[ft] {
+					return fmt.Errorf("checkts: recursive embedded type `%v` is not supported", ft)
+				}
+				g.visiting[ft] = true
+				err := g.writeFields(b, ft, indent)
+				delete(g.visiting, ft)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		rules, err := check.ParseTag(sf.Tag.Get("check"))
+		if err != nil {
+			return err
+		}
+		expr, err := g.zodType(sf.Type, rules, indent)
+		if err != nil {
+			return fmt.Errorf("checkts: field %s: %w", sf.Name, err)
+		}
+		if strings.Contains(","+opts+",", ",omitempty,") && !hasRule(rules, "required") {
+			expr += ".optional()"
+		}
+
+		fmt.Fprintf(b, "%s%s: %s,", indent, jsonString(name), expr)
+		if comments := unsupported(sf.Type, rules); len(comments) > 0 {
+			fmt.Fprintf(b, " // check: %s", strings.Join(comments, ", "))
+		}
+		b.WriteString("\n")
+	}
+
+	return nil
+}
+
+func (g *generator) zodType(t reflect.Type, rules []check.Rule, indent string) (string, error) {
+	if t.Kind() == reflect.Ptr {
+		expr, err := g.zodType(t.Elem(), rules, indent)
+		if err != nil || hasRule(rules, "required") {
+			return expr, err
+		}
+		return expr + ".nullable()", nil
+	}
+
+	var expr string
+	switch t.Kind() {
+	case reflect.String:
+		expr = "z.string()"
+		if values, ok := ruleParam(rules, "in"); ok {
+			return "z.enum(" + jsonString(strings.Split(values, "|")) + ")", nil
+		}
+	case reflect.Bool:
+		expr = "z.boolean()"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		expr = "z.number().int()"
+		if t.Kind() >= reflect.Uint {
+			expr += ".nonnegative()"
+		}
+	case reflect.Float32, reflect.Float64:
+		expr = "z.number()"
+	case reflect.Slice, reflect.Array:
+		elem, err := g.zodType(t.Elem(), nil, indent)
+		if err != nil {
+			return "", err
+		}
+		expr = "z.array(" + elem + ")"
+	case reflect.Map:
+		elem, err := g.zodType(t.Elem(), nil, indent)
+		if err != nil {
+			return "", err
+		}
+		expr = "z.record(z.string(), " + elem + ")"
+	case reflect.Struct:
+		if t == timeType {
+			return "z.coerce.date()", nil
+		}
+		return g.zodObject(t, indent)
+	case reflect.Interface:
+		return "z.unknown()", nil
+	default:
+		return "", fmt.Errorf("unsupported type `%v`", t)
+	}
+
+	for _, rule := range rules {
+		if method, ok := zodMethod(t.Kind(), rule); ok {
+			expr += method
+		}
+	}
+
+	return expr, nil
+}
+
+// zodMethod returns the Zod method call which corresponds to the rule,
+// for values of the specified kind. The parameters of the rules are parsed
+// and formatted again, so that only valid numbers are emitted. Rules with
+// invalid parameters are not supported.
+func zodMethod(kind reflect.Kind, rule check.Rule) (string, bool) {
+	sized := kind == reflect.String || kind == reflect.Slice || kind == reflect.Array
+	numeric := kind >= reflect.Int && kind <= reflect.Float64
+
+	switch {
+	case rule.Name == "required" && sized:
+		return ".min(1)", true
+	case rule.Name == "len" && sized:
+		return zodCall("length", jsLength, rule.Param)
+	case rule.Name == "min_len" && sized:
+		return zodCall("min", jsLength, rule.Param)
+	case rule.Name == "max_len" && sized:
+		return zodCall("max", jsLength, rule.Param)
+	case numeric && (rule.Name == "lt" || rule.Name == "lte" || rule.Name == "gt" || rule.Name == "gte"):
+		return zodCall(rule.Name, jsNumber, rule.Param)
+	case numeric && rule.Name == "positive":
+		return ".positive()", true
+	case numeric && rule.Name == "negative":
+		return ".negative()", true
+	case numeric && rule.Name == "non_negative":
+		return ".nonnegative()", true
+	case numeric && rule.Name == "multiple_of":
+		return zodCall("multipleOf", jsNumber, rule.Param)
+	case numeric && rule.Name == "in":
+		var elems []string
+		for _, param := range strings.Split(rule.Param, "|") {
+			elem, ok := jsNumber(param)
+			if !ok {
+				return "", false
+			}
+			elems = append(elems, elem)
+		}
+		return ".refine((v) => [" + strings.Join(elems, ", ") + "].includes(v))", true
+	case kind == reflect.String && rule.Name == "match":
+		return ".regex(new RegExp(" + jsonString(rule.Param) + "))", true
+	case kind == reflect.String && (rule.Name == "email" || rule.Name == "url" || rule.Name == "uuid" || rule.Name == "ip"):
+		return "." + rule.Name + "()", true
+	}
+
+	return "", false
+}
+
+// zodCall returns the call of the Zod method, with the param formatted as
+// a JavaScript literal by the literal function.
+func zodCall(method string, literal func(string) (string, bool), param string) (string, bool) {
+	arg, ok := literal(param)
+	if !ok {
+		return "", false
+	}
+
+	return "." + method + "(" + arg + ")", true
+}
+
+// jsLength returns the JavaScript literal of the length param, if it is
+// a non-negative integer.
+func jsLength(param string) (string, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(param))
+	if err != nil || n < 0 {
+		return "", false
+	}
+
+	return strconv.Itoa(n), true
+}
+
+// jsNumber returns the JavaScript literal of the number param, if it is
+// a finite number.
+func jsNumber(param string) (string, bool) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(param), 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", false
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, 64), true
+}
+
+// unsupported returns the rules of the field which cannot be expressed
+// using Zod.
+func unsupported(t reflect.Type, rules []check.Rule) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var names []string
+	for _, rule := range rules {
+		if rule.Name == "required" || (rule.Name == "in" && t.Kind() == reflect.String) {
+			continue
+		}
+		if _, ok := zodMethod(t.Kind(), rule); ok {
+			continue
+		}
+
+		name := rule.Name
+		if rule.Param != "" {
+			name += "=" + commentText(rule.Param)
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// commentText returns s with the line terminators replaced by spaces, so
+// that it can be included in a single line comment.
+func commentText(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\n', '\r', '\u2028', '\u2029':
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+func hasRule(rules []check.Rule, name string) bool {
+	_, ok := ruleParam(rules, name)
+	return ok
+}
+
+func ruleParam(rules []check.Rule, name string) (string, bool) {
+	for _, rule := range rules {
+		if rule.Name == name {
+			return rule.Param, true
+		}
+	}
+
+	return "", false
+}
+
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
@@ -1,7 +1,6 @@
 package check
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -27,6 +26,15 @@ var cmpOps = map[cmpOp]string{
 	gte: "gte",
 }
 
+var cmpCodes = map[cmpOp]string{
+	eq:  CodeEq,
+	ne:  CodeNe,
+	lt:  CodeLt,
+	lte: CodeLte,
+	gt:  CodeGt,
+	gte: CodeGte,
+}
+
 var cmpErrs = map[cmpOp]string{
 	eq:  "`%s` comparison failed: `%v` is not equal to `%v`",
 	ne:  "`%s` comparison failed: `%v` is equal to `%v`",
@@ -36,6 +44,18 @@ var cmpErrs = map[cmpOp]string{
 	gte: "`%s` comparison failed: `%v` is not greater than or equal to `%v`",
 }
 
+// Comparer is implemented by types which define an ordering of their values,
+// allowing them to be used with the ordered comparisons (e.g. Lt, Gte).
+// Compare returns a negative number if the value is less than other, zero
+// if they are equal, and a positive number otherwise. It returns an error
+// if the values cannot be compared (e.g. amounts of different currencies).
+//
+// Types which do not implement Comparer, but have Before and After methods
+// accepting values of the same type (like time.Time) are supported as well.
+type Comparer interface {
+	Compare(other interface{}) (int, error)
+}
+
 type cmpField struct {
 	op   cmpOp
 	term interface{}
@@ -43,7 +63,7 @@ type cmpField struct {
 
 func newCmpField(op cmpOp, term interface{}) (*cmpField, error) {
 	if op < eq || op > gte {
-		return nil, fmt.Errorf("invalid comparison operator `%d`", op)
+		return nil, newError(CodeInvalid, nil, nil, "invalid comparison operator `%d`", op)
 	}
 
 	return &cmpField{
@@ -54,12 +74,21 @@ func newCmpField(op cmpOp, term interface{}) (*cmpField, error) {
 
 func compare(x interface{}, cmp *cmpField) error {
 	if cmp == nil {
-		return errors.New("comparison field cannot be nil")
+		return newError(CodeInvalid, x, nil, "comparison field cannot be nil")
 	}
 
 	op := cmp.op
 	if op < eq || op > gte {
-		return fmt.Errorf("invalid comparison operator `%d`", op)
+		return newError(CodeInvalid, x, nil, "invalid comparison operator `%d`", op)
+	}
+
+	x, term, err := unwrapOperands(x, cmp.term)
+	if err != nil {
+		return err
+	}
+	cmp = &cmpField{op: op, term: term}
+	if c, ok := x.(Comparer); ok {
+		return compareComparer(c, x, cmp)
 	}
 	v := reflect.ValueOf(x)
 
@@ -78,10 +107,51 @@ func compare(x interface{}, cmp *cmpField) error {
 			return compareTime(t, cmp)
 		}
 	}
+	if n, ok := compareBeforeAfter(v, reflect.ValueOf(cmp.term)); ok {
+		return compareResult(n, x, cmp)
+	}
 
 	return compareInterface(x, cmp)
 }
 
+// unwrapOperands converts the operands of a comparison to values which can
+// be compared directly. The values of driver.Valuer implementations (e.g.
+// sql.NullString) are used instead of the implementations themselves, and
+// fmt.Stringer implementations are converted to strings, when compared to
+// strings. Returns an error if a driver.Valuer returns a NULL value.
+func unwrapOperands(x, term interface{}) (interface{}, interface{}, error) {
+	x, err := driverValue(x)
+	if err != nil {
+		return nil, nil, err
+	}
+	if term, err = driverValue(term); err != nil {
+		return nil, nil, err
+	}
+
+	xKind, termKind := reflect.ValueOf(x).Kind(), reflect.ValueOf(term).Kind()
+	if s, ok := x.(fmt.Stringer); ok && termKind == reflect.String && xKind != reflect.String {
+		x = s.String()
+	}
+	if s, ok := term.(fmt.Stringer); ok && xKind == reflect.String && termKind != reflect.String {
+		term = s.String()
+	}
+
+	return x, term, nil
+}
+
+func driverValue(x interface{}) (interface{}, error) {
+	v, null, err := valuerValue(x)
+	if err != nil {
+		return nil, err
+	}
+	if null {
+		return nil, newError(CodeNil, nil, map[string]interface{}{"type": fmt.Sprintf("%T", x)},
+			"value of type `%T` is null", x)
+	}
+
+	return v, nil
+}
+
 func compareInt64(x int64, cmp *cmpField) error {
 	term, err := toInt64(cmp.term)
 	if err != nil {
@@ -106,7 +176,7 @@ func compareInt64(x int64, cmp *cmpField) error {
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		return cmpError(op, x, term)
 	}
 
 	return nil
@@ -136,7 +206,7 @@ func compareUint64(x uint64, cmp *cmpField) error {
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		return cmpError(op, x, term)
 	}
 
 	return nil
@@ -166,7 +236,7 @@ func compareFloat64(x float64, cmp *cmpField) error {
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		return cmpError(op, x, term)
 	}
 
 	return nil
@@ -196,7 +266,7 @@ func compareString(x string, cmp *cmpField) error {
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		return cmpError(op, x, term)
 	}
 
 	return nil
@@ -226,7 +296,71 @@ func compareTime(x time.Time, cmp *cmpField) error {
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		return cmpError(op, x, term)
+	}
+
+	return nil
+}
+
+func compareComparer(c Comparer, x interface{}, cmp *cmpField) error {
+	n, err := c.Compare(cmp.term)
+	if err != nil {
+		return newError(CodeInvalid, x, map[string]interface{}{"term": cmp.term},
+			"cannot compare `%v` and `%v`: %s", x, cmp.term, err)
+	}
+
+	return compareResult(n, x, cmp)
+}
+
+// compareBeforeAfter compares the values using their Before and After
+// methods, if any. The returned flag is false if the values cannot be
+// compared this way.
+func compareBeforeAfter(x, term reflect.Value) (int, bool) {
+	if !x.IsValid() || !term.IsValid() || x.Type() != term.Type() {
+		return 0, false
+	}
+
+	before, after := x.MethodByName("Before"), x.MethodByName("After")
+	if !before.IsValid() || !after.IsValid() {
+		return 0, false
+	}
+	for _, m := range []reflect.Value{before, after} {
+		t := m.Type()
+		if t.NumIn() != 1 || t.In(0) != x.Type() || t.NumOut() != 1 || t.Out(0).Kind() != reflect.Bool {
+			return 0, false
+		}
+	}
+
+	args := []reflect.Value{term}
+	switch {
+	case before.Call(args)[0].Bool():
+		return -1, true
+	case after.Call(args)[0].Bool():
+		return 1, true
+	}
+
+	return 0, true
+}
+
+func compareResult(n int, x interface{}, cmp *cmpField) error {
+	var ok bool
+	switch cmp.op {
+	case eq:
+		ok = n == 0
+	case ne:
+		ok = n != 0
+	case lt:
+		ok = n < 0
+	case lte:
+		ok = n <= 0
+	case gt:
+		ok = n > 0
+	case gte:
+		ok = n >= 0
+	}
+
+	if !ok {
+		return cmpError(cmp.op, x, cmp.term)
 	}
 
 	return nil
@@ -243,12 +377,24 @@ func compareInterface(x interface{}, cmp *cmpField) error {
 	case ne:
 		ok = !equal(x, term)
 	default:
-		return fmt.Errorf("invalid operation `%s` for values `%v` and `%v`", cmpOps[op], x, term)
+		return newError(CodeInvalid, x, map[string]interface{}{"term": term},
+			"invalid operation `%s` for values `%v` and `%v`", cmpOps[op], x, term)
 	}
 
 	if !ok {
-		return fmt.Errorf(cmpErrs[op], cmpOps[op], x, term)
+		if op == eq {
+			if d := diff(x, term); d != nil {
+				return newError(CodeEq, x, map[string]interface{}{"term": term, "diff": d},
+					cmpErrs[op]+" (first difference %s)", cmpOps[op], x, term, d)
+			}
+		}
+		return cmpError(op, x, term)
 	}
 
 	return nil
 }
+
+func cmpError(op cmpOp, x, term interface{}) error {
+	return newError(cmpCodes[op], x, map[string]interface{}{"term": term},
+		cmpErrs[op], cmpOps[op], x, term)
+}
@@ -0,0 +1,82 @@
+package check
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// CountryCodeFormat specifies the accepted formats of ISO 3166-1 country
+// codes. Formats can be combined (e.g. `Alpha2 | Alpha3`).
+type CountryCodeFormat int
+
+// Country code formats.
+const (
+	// Alpha2 represents two-letter country codes (e.g. `DE`).
+	Alpha2 CountryCodeFormat = 1 << iota
+
+	// Alpha3 represents three-letter country codes (e.g. `DEU`).
+	Alpha3
+)
+
+var countryCodesAlpha3 = alpha3CountryCodes(countryCodes)
+
+func alpha3CountryCodes(codes map[string]string) map[string]bool {
+	alpha3 := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		alpha3[code] = true
+	}
+
+	return alpha3
+}
+
+// CountryCode checks if the code parameter is a valid ISO 3166-1 country
+// code, in any of the specified formats. Country codes are uppercase.
+// The code can be empty if the required parameter is false.
+func CountryCode(code string, formats CountryCodeFormat, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(code) {
+			return requiredErr(required, "country code cannot be empty")
+		}
+
+		_, alpha2 := countryCodes[code]
+		if (formats&Alpha2 != 0 && alpha2) || (formats&Alpha3 != 0 && countryCodesAlpha3[code]) {
+			return nil
+		}
+
+		return newError(CodeCountry, code, nil, "invalid country code `%s`", code)
+	}
+}
+
+// CurrencyCode checks if the code parameter is a valid ISO 4217 currency
+// code (e.g. `EUR`). Currency codes are uppercase. The code can be empty
+// if the required parameter is false.
+func CurrencyCode(code string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(code) {
+			return requiredErr(required, "currency code cannot be empty")
+		}
+		if !currencyCodes[code] {
+			return newError(CodeCurrency, code, nil, "invalid currency code `%s`", code)
+		}
+
+		return nil
+	}
+}
+
+// LanguageCode checks if the code parameter is a valid BCP 47 language tag
+// (e.g. `en`, `pt-BR`, `sr-Latn-RS`). ISO 639 language codes are valid
+// language tags. All subtags must be registered in the IANA language subtag
+// registry. The code can be empty if the required parameter is false.
+func LanguageCode(code string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(code) {
+			return requiredErr(required, "language code cannot be empty")
+		}
+		if _, err := language.Parse(code); err != nil || strings.Contains(code, "_") {
+			return newError(CodeLanguage, code, nil, "invalid language code `%s`", code)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package check
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// LtCollate checks if x sorts before the comparison term, according to the
+// collation rules of the specified locale (e.g. `sv-SE`).
+func LtCollate(x, term, locale string) ValidateFunc {
+	return func() error {
+		return compareCollate(x, term, locale, lt)
+	}
+}
+
+// LteCollate checks if x sorts before or equal to the comparison term,
+// according to the collation rules of the specified locale (e.g. `sv-SE`).
+func LteCollate(x, term, locale string) ValidateFunc {
+	return func() error {
+		return compareCollate(x, term, locale, lte)
+	}
+}
+
+// GtCollate checks if x sorts after the comparison term, according to the
+// collation rules of the specified locale (e.g. `sv-SE`).
+func GtCollate(x, term, locale string) ValidateFunc {
+	return func() error {
+		return compareCollate(x, term, locale, gt)
+	}
+}
+
+// GteCollate checks if x sorts after or equal to the comparison term,
+// according to the collation rules of the specified locale (e.g. `sv-SE`).
+func GteCollate(x, term, locale string) ValidateFunc {
+	return func() error {
+		return compareCollate(x, term, locale, gte)
+	}
+}
+
+func compareCollate(x, term, locale string, op cmpOp) error {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return newError(CodeInvalid, x, map[string]interface{}{"locale": locale},
+			"invalid locale `%s`", locale)
+	}
+
+	res := collate.New(tag).CompareString(x, term)
+
+	var ok bool
+	switch op {
+	case lt:
+		ok = res < 0
+	case lte:
+		ok = res <= 0
+	case gt:
+		ok = res > 0
+	case gte:
+		ok = res >= 0
+	}
+
+	if !ok {
+		return newError(cmpCodes[op], x, map[string]interface{}{"term": term, "locale": locale},
+			cmpErrs[op], cmpOps[op], x, term)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Each executes the validation function returned by f for every element of
+// the slice or array x. Returns the first error it encounters, with the Field
+// of the error set to the index of the invalid element (e.g. `[2]`).
+func Each(x interface{}, f func(i int, v interface{}) ValidateFunc) ValidateFunc {
+	return func() error {
+		v := reflect.ValueOf(x)
+		if kind := v.Kind(); kind != reflect.Slice && kind != reflect.Array {
+			return newError(CodeInvalid, x, nil, "cannot iterate over `%v`", kind)
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if err := f(i, v.Index(i).Interface())(); err != nil {
+				return withField(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return nil
+	}
+}
+
+// Keys executes the validation function returned by f for every key of the
+// map m. The keys are visited in sorted order. Returns the first error it
+// encounters, with the Field of the error set to the invalid key (e.g. `[id]`).
+func Keys(m interface{}, f func(k interface{}) ValidateFunc) ValidateFunc {
+	return func() error {
+		return eachEntry(m, func(k, _ reflect.Value) ValidateFunc {
+			return f(k.Interface())
+		})
+	}
+}
+
+// Values executes the validation function returned by f for every entry of
+// the map m. The entries are visited in the sorted order of their keys.
+// Returns the first error it encounters, with the Field of the error set
+// to the key of the invalid value (e.g. `[id]`).
+func Values(m interface{}, f func(k, v interface{}) ValidateFunc) ValidateFunc {
+	return func() error {
+		return eachEntry(m, func(k, v reflect.Value) ValidateFunc {
+			return f(k.Interface(), v.Interface())
+		})
+	}
+}
+
+func eachEntry(m interface{}, f func(k, v reflect.Value) ValidateFunc) error {
+	v := reflect.ValueOf(m)
+	if kind := v.Kind(); kind != reflect.Map {
+		return newError(CodeInvalid, m, nil, "cannot iterate over map entries of `%v`", kind)
+	}
+
+	for _, key := range sortedKeys(v) {
+		if err := f(key, v.MapIndex(key))(); err != nil {
+			return withField(err, fmt.Sprintf("[%v]", key))
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessValues(keys[i], keys[j])
+	})
+
+	return keys
+}
+
+func lessValues(x, y reflect.Value) bool {
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() < y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() < y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() < y.Float()
+	case reflect.String:
+		return x.String() < y.String()
+	}
+
+	return fmt.Sprint(x) < fmt.Sprint(y)
+}
+
+// HasKey checks if the map m contains the specified key.
+func HasKey(m, key interface{}) ValidateFunc {
+	return HasKeys(m, key)
+}
+
+// HasKeys checks if the map m contains all the specified keys. Returns an
+// error for the first missing key.
+func HasKeys(m interface{}, keys ...interface{}) ValidateFunc {
+	return func() error {
+		v := reflect.ValueOf(m)
+		if kind := v.Kind(); kind != reflect.Map {
+			return newError(CodeInvalid, m, nil, "cannot look up keys of `%v`", kind)
+		}
+
+		for _, key := range keys {
+			kv, ok := mapKey(v, key)
+			if !ok || !v.MapIndex(kv).IsValid() {
+				return newError(CodeHasKey, m, map[string]interface{}{"key": key},
+					"missing key `%v`", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+// OnlyKeys checks if all the keys of the map m are among the allowed ones.
+// Returns an error for the first key which is not allowed, in sorted order,
+// with the Field of the error set to the key (e.g. `[id]`).
+func OnlyKeys(m interface{}, allowed ...interface{}) ValidateFunc {
+	return func() error {
+		v := reflect.ValueOf(m)
+		if kind := v.Kind(); kind != reflect.Map {
+			return newError(CodeInvalid, m, nil, "cannot look up keys of `%v`", kind)
+		}
+
+		allowedKeys := reflect.MakeMap(reflect.MapOf(v.Type().Key(), reflect.TypeOf(true)))
+		for _, key := range allowed {
+			if kv, ok := mapKey(v, key); ok {
+				allowedKeys.SetMapIndex(kv, reflect.ValueOf(true))
+			}
+		}
+
+		return eachEntry(m, func(k, _ reflect.Value) ValidateFunc {
+			return func() error {
+				if allowedKeys.MapIndex(k).IsValid() {
+					return nil
+				}
+				return newError(CodeOnlyKeys, k.Interface(), map[string]interface{}{"allowed": allowed},
+					"key `%v` is not allowed", k.Interface())
+			}
+		})
+	}
+}
+
+// MapValues validates every value of the map m using the specified value
+// functions (e.g. created using Rules). The entries are visited in the
+// sorted order of their keys. Returns the first error it encounters, with
+// the Field of the error set to the key of the invalid value (e.g. `[id]`).
+func MapValues(m interface{}, fns ...ValueFunc) ValidateFunc {
+	return func() error {
+		return eachEntry(m, func(_, v reflect.Value) ValidateFunc {
+			x := v.Interface()
+
+			vfs := make([]ValidateFunc, len(fns))
+			for i, fn := range fns {
+				vfs[i] = fn(x)
+			}
+			return And(vfs...)
+		})
+	}
+}
+
+// mapKey converts key to the key type of the map v, if possible.
+func mapKey(v reflect.Value, key interface{}) (reflect.Value, bool) {
+	kt := v.Type().Key()
+	kv := reflect.ValueOf(key)
+	if !kv.IsValid() {
+		if kt.Kind() == reflect.Interface {
+			return reflect.Zero(kt), true
+		}
+		return reflect.Value{}, false
+	}
+	if kv.Type() == kt || (kt.Kind() == reflect.Interface && kv.Type().Implements(kt)) {
+		return kv, true
+	}
+	if kv.Type().ConvertibleTo(kt) && kv.Kind() == kt.Kind() {
+		return kv.Convert(kt), true
+	}
+
+	return reflect.Value{}, false
+}
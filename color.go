@@ -0,0 +1,142 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+)
+
+// HexColor checks if the s parameter is a hexadecimal color, using the
+// 3-digit or 6-digit notation (e.g. `#f0c`, `#ff00cc`). The color can be
+// empty if the required parameter is false.
+func HexColor(s string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "color cannot be empty")
+		}
+
+		digits, ok := strings.CutPrefix(s, "#")
+		if !ok || (len(digits) != 3 && len(digits) != 6) || !isHexDigits(digits) {
+			return newError(CodeColor, s, map[string]interface{}{"format": "hex"},
+				"invalid hex color `%s`", s)
+		}
+
+		return nil
+	}
+}
+
+// RGB checks if the s parameter is a color in the `rgb(r, g, b)` functional
+// notation. The components must be integers between 0 and 255, or
+// percentages between 0% and 100%. The color can be empty if the required
+// parameter is false.
+func RGB(s string, required bool) ValidateFunc {
+	return colorValidator(s, required, "rgb", rgbComponent, rgbComponent, rgbComponent)
+}
+
+// RGBA checks if the s parameter is a color in the `rgba(r, g, b, a)`
+// functional notation. The color components must be integers between 0 and
+// 255, or percentages between 0% and 100%, while the alpha component must be
+// a number between 0 and 1, or a percentage. The color can be empty if the
+// required parameter is false.
+func RGBA(s string, required bool) ValidateFunc {
+	return colorValidator(s, required, "rgba", rgbComponent, rgbComponent, rgbComponent, alphaComponent)
+}
+
+// HSL checks if the s parameter is a color in the `hsl(h, s%, l%)` functional
+// notation. The hue must be a number of degrees between 0 and 360, while
+// the saturation and lightness must be percentages between 0% and 100%.
+// The color can be empty if the required parameter is false.
+func HSL(s string, required bool) ValidateFunc {
+	return colorValidator(s, required, "hsl", hueComponent, percentComponent, percentComponent)
+}
+
+// colorValidator returns a validation function which checks if the s
+// parameter uses the functional notation with the specified name, and
+// its components are accepted by the specified component functions.
+func colorValidator(s string, required bool, name string, components ...func(c string) bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "color cannot be empty")
+		}
+
+		invalid := func() error {
+			return newError(CodeColor, s, map[string]interface{}{"format": name},
+				"invalid %s color `%s`", name, s)
+		}
+
+		args, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(s)), name+"(")
+		if !ok {
+			return invalid()
+		}
+		if args, ok = strings.CutSuffix(args, ")"); !ok {
+			return invalid()
+		}
+
+		parts := strings.Split(args, ",")
+		if len(parts) != len(components) {
+			return invalid()
+		}
+		for i, part := range parts {
+			if !components[i](strings.TrimSpace(part)) {
+				return invalid()
+			}
+		}
+
+		return nil
+	}
+}
+
+func rgbComponent(c string) bool {
+	if strings.HasSuffix(c, "%") {
+		return percentComponent(c)
+	}
+
+	n, err := strconv.Atoi(c)
+	return err == nil && isDigits(c) && n <= 255
+}
+
+func alphaComponent(c string) bool {
+	if strings.HasSuffix(c, "%") {
+		return percentComponent(c)
+	}
+
+	x, ok := colorNumber(c)
+	return ok && x <= 1
+}
+
+func hueComponent(c string) bool {
+	x, ok := colorNumber(strings.TrimSuffix(c, "deg"))
+	return ok && x <= 360
+}
+
+func percentComponent(c string) bool {
+	c, ok := strings.CutSuffix(c, "%")
+	if !ok {
+		return false
+	}
+
+	x, ok := colorNumber(c)
+	return ok && x <= 100
+}
+
+// colorNumber parses a non-negative decimal number, consisting of digits
+// and an optional fractional part.
+func colorNumber(c string) (float64, bool) {
+	intPart, fracPart, hasFrac := strings.Cut(c, ".")
+	if (intPart != "" && !isDigits(intPart)) || (hasFrac && !isDigits(fracPart)) || (intPart == "" && !hasFrac) {
+		return 0, false
+	}
+
+	x, err := strconv.ParseFloat(c, 64)
+	return x, err == nil
+}
+
+func isHexDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') && !(c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+
+	return true
+}
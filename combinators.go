@@ -0,0 +1,91 @@
+package check
+
+import "strings"
+
+// And checks if all the validation functions succeed. Returns the first
+// error it encounters.
+func And(vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		return run(vfs...)
+	}
+}
+
+// Or checks if at least one of the validation functions succeeds. The
+// validation functions are executed in order, until the first one succeeds.
+// If all of them fail, the returned error contains all the encountered errors.
+// Or succeeds if no validation functions are specified.
+func Or(vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		var errs Errors
+		for _, vf := range vfs {
+			err := vf()
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+
+		msgs := make([]string, len(errs))
+		for i, err := range errs {
+			msgs[i] = err.Error()
+		}
+
+		return newError(CodeOr, nil, map[string]interface{}{"errors": errs},
+			"%s", strings.Join(msgs, " or "))
+	}
+}
+
+// Not checks if the validation function fails. If it succeeds, an error
+// containing the specified message is returned.
+func Not(vf ValidateFunc, message string) ValidateFunc {
+	if message = strings.TrimSpace(message); message == "" {
+		message = "negated check succeeded"
+	}
+
+	return func() error {
+		if err := vf(); err != nil {
+			return nil
+		}
+
+		return newError(CodeNot, nil, nil, "%s", message)
+	}
+}
+
+// When executes the validation functions only if cond is true.
+// Returns the first error it encounters.
+func When(cond bool, vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		if !cond {
+			return nil
+		}
+
+		return run(vfs...)
+	}
+}
+
+// WhenFunc executes the validation functions only if the condition function
+// returns true. The condition is evaluated when the validation is performed.
+// Returns the first error it encounters.
+func WhenFunc(cond func() bool, vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		return When(cond(), vfs...)()
+	}
+}
+
+// Unless executes the validation functions only if cond is false.
+// Returns the first error it encounters.
+func Unless(cond bool, vfs ...ValidateFunc) ValidateFunc {
+	return When(!cond, vfs...)
+}
+
+// UnlessFunc executes the validation functions only if the condition function
+// returns false. The condition is evaluated when the validation is performed.
+// Returns the first error it encounters.
+func UnlessFunc(cond func() bool, vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		return When(!cond(), vfs...)()
+	}
+}
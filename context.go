@@ -0,0 +1,144 @@
+package check
+
+import (
+	"context"
+	"time"
+)
+
+// ValidateCtxFunc represents a context-aware validation function. It should
+// be used for validations which can take a long time to complete (e.g.
+// network lookups), in order to respect deadlines and cancellation.
+type ValidateCtxFunc func(ctx context.Context) error
+
+// WithContext converts the context-aware validation function into a
+// ValidateFunc which is called with the specified context.
+func WithContext(ctx context.Context, vf ValidateCtxFunc) ValidateFunc {
+	return func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return vf(ctx)
+	}
+}
+
+// RunCtx executes a list of validation functions and checks if any of them
+// fail. Before each validation function is executed, the context is checked
+// for cancellation. Returns the first error it encounters or the error of
+// the context, if it is done.
+func RunCtx(ctx context.Context, vfs ...ValidateFunc) error {
+	for _, vf := range vfs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := runCheck(vf, vf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Task represents a context-aware validation function executed by
+// RunCtxBudget, along with scheduling information.
+type Task struct {
+	// Func is the validation function of the task.
+	Func ValidateCtxFunc
+
+	// Expensive marks tasks which perform costly operations (e.g. network
+	// requests). Only expensive tasks receive a share of the time budget.
+	Expensive bool
+
+	// MinTime is the minimum amount of time allotted to an expensive task,
+	// regardless of its share of the remaining time budget. The task is
+	// still stopped by the deadline of the context passed to RunCtxBudget.
+	MinTime time.Duration
+
+	// vf is the validation function the task was created from, if any.
+	// It is used to name the task when notifying observers.
+	vf ValidateFunc
+}
+
+// CheapTask creates a task from a validation function which completes quickly.
+func CheapTask(vf ValidateFunc) Task {
+	return Task{
+		Func: func(context.Context) error {
+			return vf()
+		},
+		vf: vf,
+	}
+}
+
+// ExpensiveTask creates a task from a context-aware validation function which
+// performs costly operations. The task is allotted at least minTime.
+func ExpensiveTask(vf ValidateCtxFunc, minTime time.Duration) Task {
+	return Task{
+		Func:      vf,
+		Expensive: true,
+		MinTime:   minTime,
+	}
+}
+
+// RunCtxBudget executes a list of tasks and checks if any of them fail. If the
+// context has a deadline, the remaining time is divided equally between the
+// expensive tasks which have not been executed yet, so that a slow task
+// cannot consume the entire time budget. Each expensive task is executed
+// with a context which expires when its share of the budget is exhausted,
+// but not sooner than its minimum time, if one is specified. The minimum
+// time is capped by the deadline of ctx, which is never extended.
+// Returns the first error it encounters or the error of the context,
+// if it is done.
+func RunCtxBudget(ctx context.Context, tasks ...Task) error {
+	var expensive int
+	for _, task := range tasks {
+		if task.Expensive {
+			expensive++
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	for _, task := range tasks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !ok || !task.Expensive {
+			if err := runCheck(task.source(), func() error {
+				return task.Func(ctx)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		budget := time.Until(deadline) / time.Duration(expensive)
+		if budget < task.MinTime {
+			budget = task.MinTime
+		}
+		expensive--
+
+		if err := runCheck(task.source(), func() error {
+			return runTask(ctx, task, budget)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// source returns the validation function the task was created from, used
+// to name the task when notifying observers.
+func (t Task) source() interface{} {
+	if t.vf != nil {
+		return t.vf
+	}
+
+	return t.Func
+}
+
+func runTask(ctx context.Context, task Task, budget time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	return task.Func(ctx)
+}
@@ -0,0 +1,314 @@
+package check
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Counter is a value which violates a single rule of a rule set, generated
+// by CounterExample.
+type Counter[T any] struct {
+	// Field is the path of the struct field containing the invalid value.
+	// It is only set for struct types.
+	Field string
+
+	// Rule is the violated rule.
+	Rule Rule
+
+	// Value is the generated value.
+	Value T
+}
+
+// CounterExample generates values of type T which violate exactly one of
+// the specified rules each, while satisfying the others. The rules are
+// typically obtained using ParseTag. If T is a struct type, the rules are
+// read from the `check` struct tags of its fields, and each of the generated
+// values contains a single invalid field. The generated values can be used
+// to test the handling of each declared rule. Rules which cannot be violated
+// without violating other rules are skipped.
+func CounterExample[T any](rules []Rule) ([]Counter[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	valid, err := exampleValue(t, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var counters []Counter[T]
+	for _, c := range counterValues(valid, rules, "") {
+		counters = append(counters, Counter[T]{
+			Field: c.field,
+			Rule:  c.rule,
+			Value: c.value.Interface().(T),
+		})
+	}
+
+	return counters, nil
+}
+
+type counterValue struct {
+	field string
+	rule  Rule
+	value reflect.Value
+}
+
+// counterValues generates a value for each of the rules which can be
+// violated in isolation, starting from the valid value v.
+func counterValues(v reflect.Value, rules []Rule, path string) []counterValue {
+	var counters []counterValue
+	for i, rule := range rules {
+		for _, c := range counterCandidates(v, rules) {
+			if violatesOnly(c, rules, i) {
+				counters = append(counters, counterValue{field: path, rule: rule, value: c})
+				break
+			}
+		}
+	}
+
+	// Generate values for the fields of structs, each containing a single
+	// invalid field.
+	sv := v
+	for sv.Kind() == reflect.Ptr && !sv.IsNil() {
+		sv = sv.Elem()
+	}
+	if sv.Kind() != reflect.Struct || sv.Type() == timeType {
+		return counters
+	}
+
+	t := sv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || sf.Tag.Get("check") == "-" {
+			continue
+		}
+		fieldRules, err := ParseTag(sf.Tag.Get("check"))
+		if err != nil {
+			continue
+		}
+
+		for _, c := range counterValues(sv.Field(i), fieldRules, joinPath(path, sf.Name)) {
+			// The rules of v must still be satisfied after replacing the field.
+			if c.value = replaceField(v, i, c.value); checkRules(c.value, rules) == nil {
+				counters = append(counters, c)
+			}
+		}
+	}
+
+	return counters
+}
+
+// replaceField returns a copy of v, which is a struct or a pointer to
+// a struct, with the field at index i replaced by x.
+func replaceField(v reflect.Value, i int, x reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Ptr {
+		ptr := reflect.New(v.Type().Elem())
+		ptr.Elem().Set(replaceField(v.Elem(), i, x))
+		return ptr
+	}
+
+	cp := reflect.New(v.Type()).Elem()
+	cp.Set(v)
+	cp.Field(i).Set(x)
+	return cp
+}
+
+// violatesOnly reports whether v violates the rule at index i and
+// satisfies the other rules.
+func violatesOnly(v reflect.Value, rules []Rule, i int) bool {
+	for j, rule := range rules {
+		if failed := checkRules(v, []Rule{rule}) != nil; failed != (i == j) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// counterCandidates returns values of the type of v which are likely to
+// violate some of the rules, such as empty values, values outside of the
+// bounds declared by the rules or mutations of v.
+func counterCandidates(v reflect.Value, rules []Rule) []reflect.Value {
+	t := v.Type()
+
+	var candidates []reflect.Value
+	add := func(x interface{}) {
+		xv := reflect.ValueOf(x)
+		if xv.IsValid() && xv.Type().ConvertibleTo(t) {
+			candidates = append(candidates, xv.Convert(t))
+		}
+	}
+
+	candidates = append(candidates, reflect.Zero(t))
+	if t == timeType {
+		tm := v.Interface().(time.Time)
+		add(tm.Add(-time.Hour))
+		add(tm.Add(time.Hour))
+		for _, rule := range rules {
+			if p, err := time.Parse(time.RFC3339, rule.Param); err == nil {
+				add(p.Add(-time.Second))
+				add(p)
+				add(p.Add(time.Second))
+			}
+		}
+		return candidates
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			break
+		}
+		for _, c := range counterCandidates(v.Elem(), rules) {
+			ptr := reflect.New(t.Elem())
+			ptr.Elem().Set(c)
+			candidates = append(candidates, ptr)
+		}
+	case reflect.String:
+		for _, s := range counterStrings(v.String(), rules) {
+			add(s)
+		}
+	case reflect.Bool:
+		add(!v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		for _, x := range counterNumbers(v, rules) {
+			nv := reflect.New(t).Elem()
+			if setNumber(nv, x) {
+				candidates = append(candidates, nv)
+			}
+		}
+	case reflect.Slice:
+		for _, rule := range rules {
+			if n, err := strconv.Atoi(rule.Param); err == nil && n >= 0 {
+				for _, l := range []int{n - 1, n + 1} {
+					if l >= 0 {
+						candidates = append(candidates, counterSlice(v, l))
+					}
+				}
+			}
+		}
+	}
+
+	return candidates
+}
+
+func counterStrings(s string, rules []Rule) []string {
+	candidates := []string{" ", "!", "invalid", "example", "EXAMPLE", "12345", "ÿ", s + "!", "!" + s}
+	if s != "" {
+		runes := []rune(s)
+		candidates = append(candidates,
+			strings.ToUpper(s), strings.ToLower(s),
+			string(runes[1:]), string(runes[:len(runes)-1]),
+			s+string(runes[len(runes)-1]))
+	}
+
+	for _, rule := range rules {
+		p := rule.Param
+		switch rule.Name {
+		case "len", "min_len", "max_len":
+			if n, err := strconv.Atoi(p); err == nil {
+				for _, l := range []int{n - 1, n + 1} {
+					if l >= 0 {
+						candidates = append(candidates, resizeString(s, l))
+					}
+				}
+			}
+		case "in", "not_in":
+			for _, elem := range strings.Split(p, "|") {
+				candidates = append(candidates, elem, elem+"!")
+			}
+		case "prefix", "suffix", "contains":
+			if p != "" {
+				candidates = append(candidates, strings.ReplaceAll(s, p, ""))
+			}
+		case "eq", "ne", "not_contains":
+			candidates = append(candidates, p, p+"!", s+p)
+		case "semver_gte", "semver_lt":
+			candidates = append(candidates, "0.0.0", "999.0.0")
+		}
+	}
+
+	return candidates
+}
+
+// resizeString truncates or extends s to n runes, repeating the last
+// rune of s.
+func resizeString(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) >= n {
+		return string(runes[:n])
+	}
+
+	pad := "x"
+	if r, _ := utf8.DecodeLastRuneInString(s); r != utf8.RuneError {
+		pad = string(r)
+	}
+
+	return s + strings.Repeat(pad, n-len(runes))
+}
+
+func counterNumbers(v reflect.Value, rules []Rule) []float64 {
+	x := numberValue(v)
+	candidates := []float64{x - 1, x + 1, -1, 1}
+
+	isFloat := v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64
+	for _, rule := range rules {
+		for _, param := range strings.Split(rule.Param, "|") {
+			p, err := parseParam(v.Type(), param)
+			if err != nil {
+				continue
+			}
+
+			n := numberValue(reflect.ValueOf(p))
+			candidates = append(candidates, n-1, n, n+1)
+			if isFloat {
+				candidates = append(candidates, n-0.5, n+0.5)
+			}
+		}
+	}
+
+	return candidates
+}
+
+// setNumber sets the numeric value v to x, if x can be represented by the
+// type of v.
+func setNumber(v reflect.Value, x float64) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if x != math.Trunc(x) || v.OverflowInt(int64(x)) {
+			return false
+		}
+		v.SetInt(int64(x))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if x < 0 || x != math.Trunc(x) || v.OverflowUint(uint64(x)) {
+			return false
+		}
+		v.SetUint(uint64(x))
+	default:
+		if v.OverflowFloat(x) {
+			return false
+		}
+		v.SetFloat(x)
+	}
+
+	return true
+}
+
+// counterSlice returns a slice of n elements, with the element type of v.
+// The elements of v are reused, where possible.
+func counterSlice(v reflect.Value, n int) reflect.Value {
+	s := reflect.MakeSlice(v.Type(), n, n)
+	reflect.Copy(s, v)
+	for i := v.Len(); i < n; i++ {
+		if v.Len() > 0 {
+			s.Index(i).Set(v.Index(v.Len() - 1))
+		}
+	}
+
+	return s
+}
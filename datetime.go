@@ -0,0 +1,146 @@
+package check
+
+import (
+	"strings"
+	"time"
+)
+
+// DateTime checks if the s parameter is a date or time formatted using the
+// specified layout, as defined by the time package (e.g. `2006-01-02 15:04`).
+// The value can be empty if the required parameter is false.
+func DateTime(s, layout string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "date cannot be empty")
+		}
+		if _, err := time.Parse(layout, s); err != nil {
+			return newError(CodeDateTime, s, map[string]interface{}{"layout": layout},
+				"invalid date `%s`, expected layout `%s`", s, layout)
+		}
+
+		return nil
+	}
+}
+
+// DateISO8601 checks if the s parameter is a calendar date in the extended
+// ISO 8601 format (e.g. `2025-03-14`). The date can be empty if the required
+// parameter is false.
+func DateISO8601(s string, required bool) ValidateFunc {
+	return DateTime(s, time.DateOnly, required)
+}
+
+// RFC3339 checks if the s parameter is a timestamp in the RFC 3339 format,
+// with optional fractional seconds (e.g. `2025-03-14T15:09:26.535Z`). The
+// timestamp can be empty if the required parameter is false.
+func RFC3339(s string, required bool) ValidateFunc {
+	return DateTime(s, time.RFC3339Nano, required)
+}
+
+// Timezone checks if the s parameter is the name of a time zone in the IANA
+// Time Zone database (e.g. `Europe/London`, `UTC`). The database of the
+// system is used, unless the program imports the time/tzdata package.
+func Timezone(s string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(true, "time zone cannot be empty")
+		}
+
+		// Local is accepted by time.LoadLocation, but it is not a zone name.
+		if _, err := time.LoadLocation(s); err != nil || s == "Local" || strings.TrimSpace(s) != s {
+			return newError(CodeTimezone, s, nil, "unknown time zone `%s`", s)
+		}
+
+		return nil
+	}
+}
+
+// Before checks if the time t is before the reference time.
+func Before(t, ref time.Time) ValidateFunc {
+	return func() error {
+		if !t.Before(ref) {
+			return newError(CodeBefore, t, map[string]interface{}{"ref": ref},
+				"`%s` is not before `%s`", t.Format(time.RFC3339), ref.Format(time.RFC3339))
+		}
+
+		return nil
+	}
+}
+
+// After checks if the time t is after the reference time.
+func After(t, ref time.Time) ValidateFunc {
+	return func() error {
+		if !t.After(ref) {
+			return newError(CodeAfter, t, map[string]interface{}{"ref": ref},
+				"`%s` is not after `%s`", t.Format(time.RFC3339), ref.Format(time.RFC3339))
+		}
+
+		return nil
+	}
+}
+
+// WithinDuration checks if the time t is within the duration d of the
+// reference time, either before or after it.
+func WithinDuration(t, ref time.Time, d time.Duration) ValidateFunc {
+	return func() error {
+		diff := t.Sub(ref)
+		if diff < -d || diff > d {
+			return newError(CodeWithin, t, map[string]interface{}{"ref": ref, "duration": d},
+				"`%s` is not within `%s` of `%s`", t.Format(time.RFC3339), d, ref.Format(time.RFC3339))
+		}
+
+		return nil
+	}
+}
+
+// MinAge checks if a person born on the specified birthdate is at least
+// the specified number of years old at the current time.
+func MinAge(birthdate time.Time, years int) ValidateFunc {
+	return func() error {
+		return MinAgeAt(birthdate, years, time.Now())()
+	}
+}
+
+// MinAgeAt checks if a person born on the specified birthdate is at least
+// the specified number of years old at the time at. People born on the
+// 29th of February reach a new age on the 1st of March of common years.
+func MinAgeAt(birthdate time.Time, years int, at time.Time) ValidateFunc {
+	return func() error {
+		y, m, d := birthdate.Date()
+		at = at.In(birthdate.Location())
+		if at.Before(time.Date(y+years, m, d, 0, 0, 0, 0, birthdate.Location())) {
+			return newError(CodeMinAge, birthdate, map[string]interface{}{"years": years},
+				"age must be at least `%d` years", years)
+		}
+
+		return nil
+	}
+}
+
+// Duration checks if the s parameter is a duration in the format accepted
+// by time.ParseDuration (e.g. `1h30m`, `250ms`). The duration can be empty
+// if the required parameter is false.
+func Duration(s string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "duration cannot be empty")
+		}
+		if _, err := time.ParseDuration(s); err != nil {
+			return newError(CodeDuration, s, nil, "invalid duration `%s`", s)
+		}
+
+		return nil
+	}
+}
+
+// DurationBetween checks if the duration d is greater than or equal to min
+// and less than or equal to max.
+func DurationBetween(d, min, max time.Duration) ValidateFunc {
+	return func() error {
+		if d < min || d > max {
+			return newError(CodeDuration, d, map[string]interface{}{"min": min, "max": max},
+				"duration `%s` is not between `%s` and `%s`", d, min, max)
+		}
+
+		return nil
+	}
+}
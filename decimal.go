@@ -0,0 +1,109 @@
+package check
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Decimal checks if the s parameter is a decimal number (e.g. `-1234.50`)
+// with at most precision significant digits, of which at most scale are
+// fractional digits, like the NUMERIC(precision, scale) type of SQL
+// databases. Leading zeros of the integer part and trailing zeros of the
+// fractional part are not counted. Exponents are not allowed. The precision
+// must be positive and the scale must be between 0 and precision.
+// The value can be empty if the required parameter is false.
+func Decimal(s string, precision, scale int, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "decimal number cannot be empty")
+		}
+		if precision <= 0 || scale < 0 || scale > precision {
+			return newError(CodeInvalid, s, nil,
+				"invalid decimal precision `%d` and scale `%d`", precision, scale)
+		}
+
+		intDigits, fracDigits, ok := parseDecimal(s)
+		if !ok {
+			return newError(CodeDecimal, s, nil, "`%s` is not a valid decimal number", s)
+		}
+		if fracDigits > scale || intDigits > precision-scale {
+			return newError(CodeDecimal, s, map[string]interface{}{"precision": precision, "scale": scale},
+				"`%s` exceeds precision `%d` and scale `%d`", s, precision, scale)
+		}
+
+		return nil
+	}
+}
+
+// DecimalBetween checks if the s parameter is a decimal number (see Decimal)
+// greater than or equal to the lower bound and less than or equal to the
+// upper bound. The bounds are decimal numbers as well and are compared
+// exactly, without floating point conversions. An empty bound is ignored.
+// The value can be empty if the required parameter is false.
+func DecimalBetween(s, lower, upper string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "decimal number cannot be empty")
+		}
+
+		x, ok := decimalRat(s)
+		if !ok {
+			return newError(CodeDecimal, s, nil, "`%s` is not a valid decimal number", s)
+		}
+		if lower != "" {
+			lo, ok := decimalRat(lower)
+			if !ok {
+				return newError(CodeInvalid, s, nil, "invalid lower bound `%s`", lower)
+			}
+			if x.Cmp(lo) < 0 {
+				return newError(CodeGte, s, map[string]interface{}{"term": lower},
+					"`gte` comparison failed: `%s` is not greater than or equal to `%s`", s, lower)
+			}
+		}
+		if upper != "" {
+			hi, ok := decimalRat(upper)
+			if !ok {
+				return newError(CodeInvalid, s, nil, "invalid upper bound `%s`", upper)
+			}
+			if x.Cmp(hi) > 0 {
+				return newError(CodeLte, s, map[string]interface{}{"term": upper},
+					"`lte` comparison failed: `%s` is not less than or equal to `%s`", s, upper)
+			}
+		}
+
+		return nil
+	}
+}
+
+// parseDecimal returns the number of significant integer and fractional
+// digits of the decimal number s.
+func parseDecimal(s string) (int, int, bool) {
+	s = strings.TrimSpace(s)
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasPoint := strings.Cut(s, ".")
+	if intPart == "" || (hasPoint && fracPart == "") {
+		return 0, 0, false
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return 0, 0, false
+			}
+		}
+	}
+
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	return len(intPart), len(fracPart), true
+}
+
+func decimalRat(s string) (*big.Rat, bool) {
+	if _, _, ok := parseDecimal(s); !ok {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(strings.TrimSpace(s))
+}
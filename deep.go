@@ -0,0 +1,118 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validatable is implemented by types which validate themselves.
+type Validatable interface {
+	Validate() error
+}
+
+var validatableType = reflect.TypeOf((*Validatable)(nil)).Elem()
+
+// Deep walks the value v, including the exported fields of structs and
+// the elements of slices, arrays and maps, and calls the Validate method
+// of every value which implements Validatable. Methods with pointer
+// receivers are called for all values, including the ones which are not
+// addressable, such as map elements (on a copy of the value). Nil pointers
+// and interfaces are skipped and values referenced multiple times through
+// pointers are validated only once.
+//
+// Returns the first error it encounters, with the Field of the error set
+// to the path of the invalid value, built using Go field names
+// (e.g. `Orders[2].Items[0]`). The fields of embedded structs do not add
+// a segment to the path. Map elements are visited in sorted key order.
+func Deep(v interface{}) error {
+	w := &deepWalker{visited: map[visitKey]bool{}}
+	return w.walk(reflect.ValueOf(v), "")
+}
+
+type deepWalker struct {
+	visited map[visitKey]bool
+}
+
+func (w *deepWalker) walk(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr:
+		key := visitKey{v.Pointer(), v.Type()}
+		if v.IsNil() || w.visited[key] {
+			return nil
+		}
+		w.visited[key] = true
+		return w.walk(v.Elem(), path)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return w.walk(v.Elem(), path)
+	}
+
+	if err := w.validate(v, path); err != nil {
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+
+			fieldPath := path
+			if !sf.Anonymous {
+				fieldPath = joinPath(path, sf.Name)
+			}
+			if err := w.walk(v.Field(i), fieldPath); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			if err := w.walk(v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validate calls the Validate method of v, if it implements Validatable.
+func (w *deepWalker) validate(v reflect.Value, path string) error {
+	if !v.CanInterface() {
+		return nil
+	}
+
+	ptr := v
+	if v.CanAddr() {
+		ptr = v.Addr()
+	} else if reflect.PointerTo(v.Type()).Implements(validatableType) {
+		ptr = reflect.New(v.Type())
+		ptr.Elem().Set(v)
+	}
+
+	vv, ok := ptr.Interface().(Validatable)
+	if !ok {
+		return nil
+	}
+	if err := vv.Validate(); err != nil {
+		if path == "" {
+			return err
+		}
+		return withField(err, path)
+	}
+
+	return nil
+}
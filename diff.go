@@ -0,0 +1,162 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Kinds of differences between two values.
+const (
+	DiffValue   = "value"
+	DiffType    = "type"
+	DiffMissing = "missing"
+	DiffExtra   = "extra"
+)
+
+const maxDiffDepth = 64
+
+// Diff describes the first difference found between a value and the term it
+// was compared to. Path locates the difference inside the compared values
+// (e.g. `[2]`, `[key]`, `.Name`, `.Items[3].ID`). Kind describes the type of
+// difference. Missing elements are present only in the term, while extra
+// elements are present only in the value.
+type Diff struct {
+	Path string      `json:"path"`
+	Kind string      `json:"kind"`
+	X    interface{} `json:"x,omitempty"`
+	Y    interface{} `json:"y,omitempty"`
+}
+
+// String returns a human-readable description of the difference.
+func (d *Diff) String() string {
+	path := d.Path
+	if path == "" {
+		path = "."
+	}
+
+	switch d.Kind {
+	case DiffType:
+		return fmt.Sprintf("at `%s`: type `%T` != `%T`", path, d.X, d.Y)
+	case DiffMissing:
+		return fmt.Sprintf("at `%s`: missing `%v`", path, d.Y)
+	case DiffExtra:
+		return fmt.Sprintf("at `%s`: extra `%v`", path, d.X)
+	}
+
+	return fmt.Sprintf("at `%s`: `%v` != `%v`", path, d.X, d.Y)
+}
+
+func diff(x, y interface{}) *Diff {
+	return diffValues(reflect.ValueOf(x), reflect.ValueOf(y), "", 0)
+}
+
+func diffValues(x, y reflect.Value, path string, depth int) *Diff {
+	if !x.IsValid() || !y.IsValid() {
+		if x.IsValid() == y.IsValid() {
+			return nil
+		}
+		return &Diff{Path: path, Kind: DiffValue, X: valueOf(x), Y: valueOf(y)}
+	}
+	if x.Type() != y.Type() {
+		return &Diff{Path: path, Kind: DiffType, X: valueOf(x), Y: valueOf(y)}
+	}
+	if depth > maxDiffDepth {
+		return nil
+	}
+	depth++
+
+	switch x.Kind() {
+	case reflect.Slice, reflect.Array:
+		if x.Kind() == reflect.Slice && x.IsNil() != y.IsNil() {
+			return &Diff{Path: path, Kind: DiffValue, X: valueOf(x), Y: valueOf(y)}
+		}
+
+		n := x.Len()
+		if y.Len() < n {
+			n = y.Len()
+		}
+		for i := 0; i < n; i++ {
+			if d := diffValues(x.Index(i), y.Index(i), fmt.Sprintf("%s[%d]", path, i), depth); d != nil {
+				return d
+			}
+		}
+
+		if x.Len() > n {
+			return &Diff{Path: fmt.Sprintf("%s[%d]", path, n), Kind: DiffExtra, X: valueOf(x.Index(n))}
+		}
+		if y.Len() > n {
+			return &Diff{Path: fmt.Sprintf("%s[%d]", path, n), Kind: DiffMissing, Y: valueOf(y.Index(n))}
+		}
+	case reflect.Map:
+		if x.IsNil() != y.IsNil() {
+			return &Diff{Path: path, Kind: DiffValue, X: valueOf(x), Y: valueOf(y)}
+		}
+
+		for _, key := range sortedKeys(x) {
+			keyPath := fmt.Sprintf("%s[%v]", path, key)
+
+			yv := y.MapIndex(key)
+			if !yv.IsValid() {
+				return &Diff{Path: keyPath, Kind: DiffExtra, X: valueOf(x.MapIndex(key))}
+			}
+			if d := diffValues(x.MapIndex(key), yv, keyPath, depth); d != nil {
+				return d
+			}
+		}
+		for _, key := range sortedKeys(y) {
+			if !x.MapIndex(key).IsValid() {
+				return &Diff{Path: fmt.Sprintf("%s[%v]", path, key), Kind: DiffMissing, Y: valueOf(y.MapIndex(key))}
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			fieldPath := path + "." + x.Type().Field(i).Name
+			if d := diffValues(x.Field(i), y.Field(i), fieldPath, depth); d != nil {
+				return d
+			}
+		}
+	case reflect.Ptr, reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() == y.IsNil() {
+				return nil
+			}
+			return &Diff{Path: path, Kind: DiffValue, X: valueOf(x), Y: valueOf(y)}
+		}
+		return diffValues(x.Elem(), y.Elem(), path, depth)
+	default:
+		if !equalValues(x, y) {
+			return &Diff{Path: path, Kind: DiffValue, X: valueOf(x), Y: valueOf(y)}
+		}
+	}
+
+	return nil
+}
+
+func equalValues(x, y reflect.Value) bool {
+	switch x.Kind() {
+	case reflect.Bool:
+		return x.Bool() == y.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() == y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() == y.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return x.Complex() == y.Complex()
+	case reflect.String:
+		return x.String() == y.String()
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return x.Pointer() == y.Pointer()
+	}
+
+	return true
+}
+
+func valueOf(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+
+	return v.Interface()
+}
@@ -0,0 +1,103 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// Resolver looks up DNS records. It is implemented by *net.Resolver and can
+// be replaced in tests.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// DNS verifies hosts and domains using DNS lookups.
+type DNS struct {
+	// Resolver performs the DNS lookups.
+	// If nil, net.DefaultResolver is used.
+	Resolver Resolver
+}
+
+var defaultDNS = &DNS{}
+
+// DNSResolves checks if the host parameter resolves to at least one address,
+// using the default resolver. The host can be empty if the required
+// parameter is false.
+func DNSResolves(host string, required bool) ValidateCtxFunc {
+	return defaultDNS.Resolves(host, required)
+}
+
+// HasMX checks if the domain parameter has MX records, using the default
+// resolver. The domain can be empty if the required parameter is false.
+func HasMX(domain string, required bool) ValidateCtxFunc {
+	return defaultDNS.HasMX(domain, required)
+}
+
+// Resolves checks if the host parameter resolves to at least one address.
+// Lookup failures other than non-existent hosts are returned as errors which
+// are not of type *Error, so that the function can be wrapped by a circuit
+// breaker. The host can be empty if the required parameter is false.
+func (d *DNS) Resolves(host string, required bool) ValidateCtxFunc {
+	return func(ctx context.Context) error {
+		if isEmptyStr(host) {
+			return requiredErr(required, "host cannot be empty")
+		}
+
+		addrs, err := d.resolver().LookupHost(ctx, host)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		if len(addrs) == 0 {
+			return newError(CodeDNS, host, nil, "host `%s` does not resolve", host)
+		}
+
+		return nil
+	}
+}
+
+// HasMX checks if the domain parameter has MX records, which means that it
+// accepts email. Domains which explicitly do not accept email using a null
+// MX record (RFC 7505) are rejected. Lookup failures other than non-existent
+// domains are returned as errors which are not of type *Error, so that the
+// function can be wrapped by a circuit breaker. The domain can be empty if
+// the required parameter is false.
+func (d *DNS) HasMX(domain string, required bool) ValidateCtxFunc {
+	return func(ctx context.Context) error {
+		if isEmptyStr(domain) {
+			return requiredErr(required, "domain cannot be empty")
+		}
+
+		records, err := d.resolver().LookupMX(ctx, domain)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+
+		var accepts bool
+		for _, mx := range records {
+			if strings.TrimSuffix(mx.Host, ".") != "" {
+				accepts = true
+			}
+		}
+		if !accepts {
+			return newError(CodeMX, domain, nil, "domain `%s` does not accept email", domain)
+		}
+
+		return nil
+	}
+}
+
+func (d *DNS) resolver() Resolver {
+	if d.Resolver == nil {
+		return net.DefaultResolver
+	}
+
+	return d.Resolver
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,162 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+func isEmptyData[T ~string | ~[]byte](data T) bool {
+	return strings.TrimSpace(string(data)) == ""
+}
+
+// JSON checks if the data parameter is a valid JSON document.
+// The data can be empty if the required parameter is false.
+func JSON[T ~string | ~[]byte](data T, required bool) ValidateFunc {
+	return jsonValidator(data, required, "")
+}
+
+// JSONObject checks if the data parameter is a valid JSON document
+// containing an object. The data can be empty if the required parameter
+// is false.
+func JSONObject[T ~string | ~[]byte](data T, required bool) ValidateFunc {
+	return jsonValidator(data, required, "object")
+}
+
+// JSONArray checks if the data parameter is a valid JSON document
+// containing an array. The data can be empty if the required parameter
+// is false.
+func JSONArray[T ~string | ~[]byte](data T, required bool) ValidateFunc {
+	return jsonValidator(data, required, "array")
+}
+
+func jsonValidator[T ~string | ~[]byte](data T, required bool, kind string) ValidateFunc {
+	return func() error {
+		if isEmptyData(data) {
+			return requiredErr(required, "JSON document cannot be empty")
+		}
+
+		b := []byte(data)
+		if !json.Valid(b) {
+			return newError(CodeJSON, string(data), nil, "invalid JSON document")
+		}
+
+		trimmed := bytes.TrimSpace(b)
+		if (kind == "object" && trimmed[0] != '{') || (kind == "array" && trimmed[0] != '[') {
+			return newError(CodeJSON, string(data), map[string]interface{}{"type": kind},
+				"JSON document must contain an %s", kind)
+		}
+
+		return nil
+	}
+}
+
+// YAML checks if the data parameter is a valid YAML document. Streams of
+// multiple documents are allowed. The data can be empty if the required
+// parameter is false.
+func YAML[T ~string | ~[]byte](data T, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyData(data) {
+			return requiredErr(required, "YAML document cannot be empty")
+		}
+
+		dec := yaml.NewDecoder(strings.NewReader(string(data)))
+		for {
+			var node yaml.Node
+			err := dec.Decode(&node)
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return newError(CodeYAML, string(data), nil, "invalid YAML document: %s", err)
+			}
+		}
+	}
+}
+
+// XML checks if the data parameter is a well-formed XML document with
+// a single root element. The data can be empty if the required parameter
+// is false.
+func XML[T ~string | ~[]byte](data T, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyData(data) {
+			return requiredErr(required, "XML document cannot be empty")
+		}
+
+		dec := xml.NewDecoder(strings.NewReader(string(data)))
+
+		var depth, roots int
+		for {
+			tok, err := dec.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return newError(CodeXML, string(data), nil, "invalid XML document: %s", err)
+			}
+
+			switch t := tok.(type) {
+			case xml.StartElement:
+				if depth == 0 {
+					roots++
+				}
+				depth++
+			case xml.EndElement:
+				depth--
+			case xml.CharData:
+				if depth == 0 && len(bytes.TrimSpace(t)) > 0 {
+					return newError(CodeXML, string(data), nil, "invalid XML document: text outside of the root element")
+				}
+			}
+		}
+		if roots != 1 {
+			return newError(CodeXML, string(data), nil, "invalid XML document: expected a single root element")
+		}
+
+		return nil
+	}
+}
+
+// UnmarshalJSON decodes the JSON document data into dst, which must be
+// a non-nil pointer, and validates the result. Structs, including the ones
+// referenced by slices, arrays and maps, are validated based on the rules
+// declared in their `check` struct tags (see StructValidator). The Field of
+// each returned error contains the JSON path of the invalid value, built
+// using the names of the `json` struct tags (e.g. `items[2].email`). If dst
+// implements Validatable, its Validate method is called afterwards.
+//
+// If the document cannot be decoded, an error with the CodeJSON code is
+// returned. Otherwise, all the validation errors are returned, as Errors.
+func UnmarshalJSON(data []byte, dst interface{}) error {
+	if err := json.Unmarshal(data, dst); err != nil {
+		e := newError(CodeJSON, nil, nil, "invalid JSON document: %s", err)
+		e.Err = err
+
+		var te *json.UnmarshalTypeError
+		if errors.As(err, &te) {
+			e.Field = te.Field
+		}
+		return e
+	}
+
+	var errs Errors
+	sv := &StructValidator{NameTag: "json", All: true}
+	sv.validateValue(reflect.ValueOf(dst), "", &errs, map[visitKey]bool{})
+
+	if v, ok := dst.(Validatable); ok {
+		if err := v.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
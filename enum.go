@@ -0,0 +1,47 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Enum checks if x is equal to one of the allowed values. It is a type-safe
+// alternative to In, which reports failures using messages suitable for end
+// users (e.g. `must be one of: admin, user`). The allowed values are stored
+// in the `allowed` parameter of the error and their comma-separated list in
+// the `list` parameter.
+func Enum[T comparable](x T, allowed ...T) ValidateFunc {
+	return func() error {
+		for _, elem := range allowed {
+			if x == elem {
+				return nil
+			}
+		}
+
+		list := make([]string, len(allowed))
+		for i, elem := range allowed {
+			list[i] = fmt.Sprint(elem)
+		}
+		return enumError(x, allowed, list)
+	}
+}
+
+// EnumString checks if the string x is equal to one of the allowed values,
+// optionally ignoring case. Failures are reported like in Enum.
+func EnumString(x string, allowed []string, caseInsensitive bool) ValidateFunc {
+	return func() error {
+		for _, elem := range allowed {
+			if x == elem || (caseInsensitive && strings.EqualFold(x, elem)) {
+				return nil
+			}
+		}
+
+		return enumError(x, allowed, allowed)
+	}
+}
+
+func enumError(x, allowed interface{}, list []string) error {
+	joined := strings.Join(list, ", ")
+	return newError(CodeEnum, x, map[string]interface{}{"allowed": allowed, "list": joined},
+		"must be one of: %s", joined)
+}
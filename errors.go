@@ -0,0 +1,343 @@
+package check
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Error codes returned by the built-in validators.
+const (
+	CodeInvalid          = "invalid"
+	CodeRequired         = "required"
+	CodeEq               = "eq"
+	CodeNe               = "ne"
+	CodeLt               = "lt"
+	CodeLte              = "lte"
+	CodeGt               = "gt"
+	CodeGte              = "gte"
+	CodeIn               = "in"
+	CodeNotIn            = "not_in"
+	CodeMatch            = "match"
+	CodeEmail            = "email"
+	CodeURL              = "url"
+	CodeIBAN             = "iban"
+	CodeVAT              = "vat"
+	CodeIP               = "ip"
+	CodeMAC              = "mac"
+	CodeBillingPeriod    = "billing_period"
+	CodeAnchorDay        = "anchor_day"
+	CodeOverlap          = "overlap"
+	CodeTimeWindow       = "time_window"
+	CodeQuota            = "quota"
+	CodeBudget           = "budget"
+	CodeCycle            = "cycle"
+	CodeReference        = "reference"
+	CodeOr               = "or"
+	CodeNot              = "not"
+	CodeIdempotencyKey   = "idempotency_key"
+	CodeRequestID        = "request_id"
+	CodeLen              = "len"
+	CodeMinLen           = "min_len"
+	CodeMaxLen           = "max_len"
+	CodeUnavailable      = "unavailable"
+	CodeUUID             = "uuid"
+	CodeCreditCard       = "credit_card"
+	CodePhone            = "phone"
+	CodeVATNotRegistered = "vat_not_registered"
+	CodeBIC              = "bic"
+	CodeCountry          = "country"
+	CodeCurrency         = "currency"
+	CodeLanguage         = "language"
+	CodePrivateIP        = "private_ip"
+	CodePublicIP         = "public_ip"
+	CodeLoopbackIP       = "loopback_ip"
+	CodeNotPrivateIP     = "not_private_ip"
+	CodeNotLoopbackIP    = "not_loopback_ip"
+	CodeDNS              = "dns"
+	CodeMX               = "mx"
+	CodeAlpha            = "alpha"
+	CodeAlphanumeric     = "alphanumeric"
+	CodeNumeric          = "numeric"
+	CodeASCII            = "ascii"
+	CodePrintableASCII   = "printable_ascii"
+	CodeLowercase        = "lowercase"
+	CodeUppercase        = "uppercase"
+	CodePrintable        = "printable"
+	CodePrefix           = "prefix"
+	CodeSuffix           = "suffix"
+	CodeContains         = "contains"
+	CodeNotContains      = "not_contains"
+	CodeJSON             = "json"
+	CodeYAML             = "yaml"
+	CodeXML              = "xml"
+	CodeBase64           = "base64"
+	CodeHex              = "hex"
+	CodeBase58           = "base58"
+	CodeHash             = "hash"
+	CodeSemver           = "semver"
+	CodeULID             = "ulid"
+	CodeKSUID            = "ksuid"
+	CodeNanoID           = "nanoid"
+	CodeISBN             = "isbn"
+	CodeISSN             = "issn"
+	CodeEAN              = "ean"
+	CodeUPC              = "upc"
+	CodeLatitude         = "latitude"
+	CodeLongitude        = "longitude"
+	CodeLatLong          = "lat_long"
+	CodeColor            = "color"
+	CodeDateTime         = "datetime"
+	CodeTimezone         = "timezone"
+	CodeBefore           = "before"
+	CodeAfter            = "after"
+	CodeWithin           = "within"
+	CodeMinAge           = "min_age"
+	CodeDuration         = "duration"
+	CodeFileExists       = "file_exists"
+	CodeDirExists        = "dir_exists"
+	CodeAbsPath          = "abs_path"
+	CodeExtension        = "extension"
+	CodeFileSize         = "file_size"
+	CodeWritable         = "writable"
+	CodeMIMEType         = "mime_type"
+	CodeImageDimensions  = "image_dimensions"
+	CodeNil              = "nil"
+	CodePositive         = "positive"
+	CodeNegative         = "negative"
+	CodeNonNegative      = "non_negative"
+	CodeMultipleOf       = "multiple_of"
+	CodeDecimal          = "decimal"
+	CodeSubset           = "subset"
+	CodeSuperset         = "superset"
+	CodeDisjoint         = "disjoint"
+	CodeUnique           = "unique"
+	CodeHasKey           = "has_key"
+	CodeOnlyKeys         = "only_keys"
+	CodeSorted           = "sorted"
+	CodeMonotonic        = "monotonic"
+	CodeEnum             = "enum"
+)
+
+// Error represents a validation failure. It contains a machine-readable code,
+// the name of the validated field (if any), the validated value, the
+// parameters of the check and a human-readable message. The validated value
+// is not included in the JSON encoding of the error, as it may contain
+// sensitive data (e.g. passwords or card numbers).
+type Error struct {
+	Code    string                 `json:"code"`
+	Field   string                 `json:"field,omitempty"`
+	Value   interface{}            `json:"-"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+	Message string                 `json:"message"`
+
+	// Severity is the severity of the failure. Errors created by the
+	// validators have no severity, which is equivalent to SeverityError.
+	// Failures of the checks wrapped by Warn have the SeverityWarning
+	// severity.
+	Severity Severity `json:"severity,omitempty"`
+
+	// Err is the underlying error which caused the failure, if any.
+	Err error `json:"-"`
+
+	// The message of errors created by the validators is rendered again
+	// when the formatting changes (e.g. by runners), using the format, the
+	// arguments and the original value and parameters, before limits.
+	format       string
+	args         []interface{}
+	value        interface{}
+	params       map[string]interface{}
+	translatable bool
+}
+
+// Error returns the message of the error, prefixed by the field name,
+// if one is set.
+func (e *Error) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+
+	return e.Field + ": " + e.Message
+}
+
+// Unwrap returns the underlying error which caused the failure, if any.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as JSON. Parameters which cannot be encoded
+// (e.g. NaN numbers) are left out.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	type jsonError Error
+
+	je := jsonError(*e)
+	je.Params = encodableParams(e.Params)
+	return json.Marshal(&je)
+}
+
+// encodableParams returns the parameters which can be encoded as JSON.
+func encodableParams(params map[string]interface{}) map[string]interface{} {
+	if _, err := json.Marshal(params); err == nil {
+		return params
+	}
+
+	encodable := make(map[string]interface{}, len(params))
+	for name, param := range params {
+		if _, err := json.Marshal(param); err == nil {
+			encodable[name] = param
+		}
+	}
+
+	return encodable
+}
+
+// Errors represents a list of errors.
+type Errors []error
+
+// Error returns the messages of the errors, separated by semicolons.
+func (errs Errors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors in the list.
+func (errs Errors) Unwrap() []error {
+	return errs
+}
+
+func newError(code string, value interface{}, params map[string]interface{}, format string, args ...interface{}) *Error {
+	e := &Error{
+		Code:         code,
+		format:       format,
+		args:         args,
+		value:        value,
+		params:       params,
+		translatable: true,
+	}
+	e.render(globalFormatting())
+
+	return e
+}
+
+// render sets the value, the parameters and the message of the error, based
+// on the formatting f. The limits of f are applied to the value and the
+// parameters before they are passed to the translator, if one is set.
+func (e *Error) render(f formatting) {
+	e.Value, e.Params = f.limits.value(e.value), f.limits.params(e.params)
+	if e.translatable {
+		if msg, ok := translate(e.Code, e.Value, e.Params); ok {
+			e.Message = msg
+			return
+		}
+	}
+
+	e.Message = render(f, e.format, e.args...)
+}
+
+func withFormatting(err error, f formatting) error {
+	switch e := err.(type) {
+	case Errors:
+		fes := make(Errors, len(e))
+		for i, err := range e {
+			fes[i] = withFormatting(err, f)
+		}
+		return fes
+	case *Error:
+		if e.format == "" {
+			return err
+		}
+
+		fe := *e
+		fe.render(globalFormatting().override(f))
+		return &fe
+	}
+
+	return err
+}
+
+// Field executes the validation functions and sets the specified field name
+// on the first error it encounters. If the error already references a field,
+// the name is used as a prefix (e.g. `contacts[2]`, `user.email`). Errors which
+// are not of type *Error are converted to an *Error with the CodeInvalid code.
+func Field(name string, vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		err := run(vfs...)
+		if err == nil {
+			return nil
+		}
+
+		return withField(err, name)
+	}
+}
+
+// FieldError sets the specified field name on err, in the same way as Field
+// does for the errors of validation functions. Returns nil if err is nil.
+// It is mainly used by code which performs checks directly, such as the
+// code generated by checkgen.
+func FieldError(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withField(err, name)
+}
+
+func withField(err error, name string) *Error {
+	e := toError(err)
+	e.Field = joinPath(name, e.Field)
+
+	return e
+}
+
+// toError returns a copy of err, if it is of type *Error, or converts it
+// to an *Error with the CodeInvalid code.
+func toError(err error) *Error {
+	if ve, ok := err.(*Error); ok {
+		e := *ve
+		return &e
+	}
+
+	return &Error{Code: CodeInvalid, Message: err.Error()}
+}
+
+// WithMessage executes the validation function and replaces the message of
+// the returned error with the specified one. The original error remains
+// accessible through errors.Is and errors.As.
+func WithMessage(vf ValidateFunc, message string) ValidateFunc {
+	return func() error {
+		err := vf()
+		if err == nil {
+			return nil
+		}
+
+		return withMessage(err, message)
+	}
+}
+
+// WithMessagef executes the validation function and replaces the message of
+// the returned error with one formatted according to the format specifier.
+// The original error remains accessible through errors.Is and errors.As.
+func WithMessagef(vf ValidateFunc, format string, args ...interface{}) ValidateFunc {
+	return func() error {
+		err := vf()
+		if err == nil {
+			return nil
+		}
+
+		e := withMessage(err, "")
+		e.format, e.args = format, args
+		e.render(globalFormatting())
+		return e
+	}
+}
+
+func withMessage(err error, message string) *Error {
+	e := toError(err)
+	e.Message, e.Err = message, err
+	e.format, e.args, e.translatable = "", nil, false
+
+	return e
+}
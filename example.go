@@ -0,0 +1,525 @@
+package check
+
+import (
+	"math"
+	"reflect"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// exampleFormats contains the values generated for the format rules.
+var exampleFormats = map[string]string{
+	"decimal":     "0",
+	"email":       "user@example.com",
+	"email_list":  "user@example.com",
+	"url":         "https://example.com",
+	"iban":        "GB82WEST12345698765432",
+	"vat":         "DE136695976",
+	"ip":          "192.0.2.1",
+	"private_ip":  "10.0.0.1",
+	"public_ip":   "8.8.8.8",
+	"loopback_ip": "127.0.0.1",
+	"mac":         "00:00:5e:00:53:01",
+	"uuid":        "0f8fad5b-d9cb-469f-a165-70867728950e",
+	"phone":       "+442079460958",
+	"bic":         "DEUTDEFF",
+	"country":     "GB",
+	"currency":    "EUR",
+	"language":    "en",
+	"credit_card": "4111111111111111",
+	"json":        "{}",
+	"yaml":        "{}",
+	"xml":         "<example/>",
+	"base64":      "ZXhhbXBsZQ==",
+	"base64url":   "ZXhhbXBsZQ",
+	"hex":         "6578616d706c65",
+	"base58":      "4h3c6RH52R",
+}
+
+// exampleTime is the time generated for time.Time values without bounds.
+var exampleTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Example generates a value of type T which satisfies the specified rules,
+// typically obtained using ParseTag. If T is a struct type, its fields are
+// populated based on the rules declared in their `check` struct tags. The
+// generated values are deterministic, so they can be used for seeding tests
+// and documentation. An error is returned if a value satisfying all the
+// rules cannot be generated.
+func Example[T any](rules []Rule) (T, error) {
+	var x T
+
+	v, err := exampleValue(reflect.TypeOf(&x).Elem(), rules)
+	if err != nil {
+		return x, err
+	}
+	reflect.ValueOf(&x).Elem().Set(v)
+
+	return x, nil
+}
+
+func exampleValue(t reflect.Type, rules []Rule) (reflect.Value, error) {
+	byName := map[string]Rule{}
+	for _, rule := range rules {
+		byName[rule.Name] = rule
+	}
+
+	v := reflect.New(t).Elem()
+	if err := exampleFill(v, byName); err != nil {
+		return v, err
+	}
+	if err := checkRules(v, rules); err != nil {
+		return v, newError(CodeInvalid, v.Interface(), nil,
+			"cannot generate a value satisfying the rules: %s", toError(err).Message)
+	}
+
+	return v, nil
+}
+
+// checkRules validates v against the specified rules. Rules other than
+// `required` are applied to the values referenced by pointers, if any.
+func checkRules(v reflect.Value, rules []Rule) error {
+	for _, rule := range rules {
+		fn, ok := lookupRule(rule.Name)
+		if !ok {
+			return newError(CodeInvalid, nil, nil, "unknown rule `%s`", rule.Name)
+		}
+
+		rv := v
+		if rule.Name != "required" {
+			for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+				rv = rv.Elem()
+			}
+			if rv.Kind() == reflect.Ptr {
+				continue
+			}
+		}
+
+		x := rv.Interface()
+		if rule.Name != "required" {
+			var null bool
+			var err error
+			if x, null, err = valuerValue(x); err != nil {
+				return err
+			} else if null {
+				continue
+			}
+		}
+
+		vf, err := fn(x, rule.Param)
+		if err != nil {
+			return err
+		}
+		if err = vf(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exampleFill(v reflect.Value, rules map[string]Rule) error {
+	t := v.Type()
+	if t == timeType {
+		v.Set(reflect.ValueOf(exampleTimeValue(rules)))
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if len(rules) == 0 {
+			return nil
+		}
+		ptr := reflect.New(t.Elem())
+		if err := exampleFill(ptr.Elem(), rules); err != nil {
+			return err
+		}
+		v.Set(ptr)
+	case reflect.String:
+		s, err := exampleString(rules)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b := true
+		if rule, ok := rules["eq"]; ok {
+			b, _ = strconv.ParseBool(rule.Param)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return exampleNumber(v, rules)
+	case reflect.Slice, reflect.Array:
+		n := 0
+		if _, ok := rules["required"]; ok {
+			n = 1
+		}
+		if l, ok := exampleLen(rules); ok {
+			n = l
+		}
+		if t.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(t, n, n))
+		}
+		// The elements of subsets are chosen from the allowed values.
+		var elemRules map[string]Rule
+		if rule, ok := rules["subset"]; ok {
+			elemRules = map[string]Rule{"in": {Name: "in", Param: rule.Param}}
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := exampleFill(v.Index(i), elemRules); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(t))
+	case reflect.Struct:
+		return exampleStruct(v)
+	}
+
+	return nil
+}
+
+func exampleStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		tag := sf.Tag.Get("check")
+		if tag == "-" {
+			continue
+		}
+
+		rules, err := ParseTag(tag)
+		if err != nil {
+			return withField(err, sf.Name)
+		}
+		fv, err := exampleValue(sf.Type, rules)
+		if err != nil {
+			return withField(err, sf.Name)
+		}
+		if v.Field(i).CanSet() {
+			v.Field(i).Set(fv)
+		}
+	}
+
+	return nil
+}
+
+func exampleString(rules map[string]Rule) (string, error) {
+	// Rules which determine the value completely.
+	if rule, ok := rules["eq"]; ok {
+		return rule.Param, nil
+	}
+	if rule, ok := rules["in"]; ok {
+		return exampleIn(rule.Param, rules), nil
+	}
+	if rule, ok := rules["match"]; ok {
+		return exampleMatch(rule.Param)
+	}
+	if rule, ok := rules["hash"]; ok {
+		if size, ok := hashSizes[strings.ToLower(rule.Param)]; ok {
+			return strings.Repeat("0", 2*size), nil
+		}
+	}
+
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if s, ok := exampleFormats[name]; ok {
+			return s, nil
+		}
+	}
+
+	s := "example"
+	if _, ok := rules["numeric"]; ok {
+		s = "12345"
+	} else if _, ok := rules["uppercase"]; ok {
+		s = "EXAMPLE"
+	}
+	pad := s[len(s)-1:]
+
+	// Adjust the value to satisfy the substring and length rules.
+	if rule, ok := rules["prefix"]; ok {
+		s = rule.Param + s
+	}
+	if rule, ok := rules["contains"]; ok {
+		s += rule.Param
+	}
+	if rule, ok := rules["suffix"]; ok {
+		s += rule.Param
+	}
+
+	n := utf8.RuneCountInString(s)
+	target := n
+	if rule, ok := rules["min_len"]; ok {
+		if min, err := strconv.Atoi(rule.Param); err == nil && target < min {
+			target = min
+		}
+	}
+	if rule, ok := rules["max_len"]; ok {
+		if max, err := strconv.Atoi(rule.Param); err == nil && target > max {
+			target = max
+		}
+	}
+	if rule, ok := rules["len"]; ok {
+		if l, err := strconv.Atoi(rule.Param); err == nil {
+			target = l
+		}
+	}
+
+	switch {
+	case target > n:
+		suffix := ""
+		if rule, ok := rules["suffix"]; ok {
+			s, suffix = strings.TrimSuffix(s, rule.Param), rule.Param
+		}
+		s += strings.Repeat(pad, target-n) + suffix
+	case target < n:
+		s = string([]rune(s)[:target])
+	}
+
+	return s, nil
+}
+
+// exampleIn returns the first value of the `in` rule parameter which is
+// not excluded by the `not_in` rule.
+func exampleIn(param string, rules map[string]Rule) string {
+	values := strings.Split(param, "|")
+
+	excluded := map[string]bool{}
+	if rule, ok := rules["not_in"]; ok {
+		for _, v := range strings.Split(rule.Param, "|") {
+			excluded[v] = true
+		}
+	}
+	for _, v := range values {
+		if !excluded[v] {
+			return v
+		}
+	}
+
+	return values[0]
+}
+
+func exampleLen(rules map[string]Rule) (int, bool) {
+	if rule, ok := rules["len"]; ok {
+		n, err := strconv.Atoi(rule.Param)
+		return n, err == nil
+	}
+	if rule, ok := rules["min_len"]; ok {
+		n, err := strconv.Atoi(rule.Param)
+		return n, err == nil && n > 0
+	}
+
+	return 0, false
+}
+
+func exampleNumber(v reflect.Value, rules map[string]Rule) error {
+	t := v.Type()
+	param := func(name string) (float64, bool, error) {
+		rule, ok := rules[name]
+		if !ok {
+			return 0, false, nil
+		}
+		p, err := parseParam(t, rule.Param)
+		if err != nil {
+			return 0, false, err
+		}
+		return numberValue(reflect.ValueOf(p)), true, nil
+	}
+
+	var x float64
+	if _, ok := rules["required"]; ok {
+		x = 1
+	}
+	if rule, ok := rules["in"]; ok {
+		p, err := parseParam(t, exampleIn(rule.Param, rules))
+		if err != nil {
+			return err
+		}
+		x = numberValue(reflect.ValueOf(p))
+	}
+	if eq, ok, err := param("eq"); err != nil {
+		return err
+	} else if ok {
+		x = eq
+	}
+
+	// Compute the bounds of the accepted interval.
+	lo, hi := math.Inf(-1), math.Inf(1)
+	var loStrict, hiStrict bool
+	for _, name := range []string{"gte", "gt", "lte", "lt"} {
+		p, ok, err := param(name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "gte", "gt":
+			if p >= lo {
+				lo, loStrict = p, name == "gt"
+			}
+		case "lte", "lt":
+			if p <= hi {
+				hi, hiStrict = p, name == "lt"
+			}
+		}
+	}
+
+	// Apply the sign rules.
+	if _, ok := rules["positive"]; ok && 0 >= lo {
+		lo, loStrict = 0, true
+	}
+	if _, ok := rules["non_negative"]; ok && 0 > lo {
+		lo, loStrict = 0, false
+	}
+	if _, ok := rules["negative"]; ok && 0 <= hi {
+		hi, hiStrict = 0, true
+	}
+
+	// Exclude the bounds of strict comparisons. Floating point values are
+	// moved towards the middle of the interval, if it is bounded.
+	isFloat := t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	bounded := !math.IsInf(lo, -1) && !math.IsInf(hi, 1)
+	switch {
+	case loStrict && isFloat && bounded:
+		lo = (lo + hi) / 2
+	case loStrict:
+		lo++
+	}
+	switch {
+	case hiStrict && isFloat && bounded:
+		hi = math.Max(lo, (lo+hi)/2)
+	case hiStrict:
+		hi--
+	}
+	if x < lo {
+		x = lo
+	}
+	if x > hi {
+		x = hi
+	}
+
+	// Round the value to the nearest multiple within the interval.
+	step := 1.0
+	if m, ok, err := param("multiple_of"); err != nil {
+		return err
+	} else if ok && m != 0 {
+		step = math.Abs(m)
+		if x = math.Ceil(x/step) * step; x > hi {
+			x = math.Floor(hi/step) * step
+		}
+	}
+	if ne, ok, err := param("ne"); err != nil {
+		return err
+	} else if ok && x == ne {
+		x += step
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(x))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(math.Max(x, 0)))
+	default:
+		v.SetFloat(x)
+	}
+
+	return nil
+}
+
+func numberValue(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	}
+
+	return v.Float()
+}
+
+func exampleTimeValue(rules map[string]Rule) time.Time {
+	param := func(name string) (time.Time, bool) {
+		rule, ok := rules[name]
+		if !ok {
+			return time.Time{}, false
+		}
+		t, err := time.Parse(time.RFC3339, rule.Param)
+		return t, err == nil
+	}
+
+	if t, ok := param("eq"); ok {
+		return t
+	}
+	if t, ok := param("gte"); ok {
+		return t
+	}
+	if t, ok := param("gt"); ok {
+		return t.Add(time.Second)
+	}
+	if t, ok := param("lte"); ok && t.Before(exampleTime) {
+		return t
+	}
+	if t, ok := param("lt"); ok && !t.After(exampleTime) {
+		return t.Add(-time.Second)
+	}
+
+	return exampleTime
+}
+
+// exampleMatch generates the shortest string matching the specified
+// regular expression.
+func exampleMatch(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", newError(CodeInvalid, pattern, map[string]interface{}{"pattern": pattern},
+			"invalid pattern `%s`", pattern)
+	}
+
+	var sb strings.Builder
+	writeMatch(&sb, re.Simplify())
+	return sb.String(), nil
+}
+
+func writeMatch(sb *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			sb.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		if len(re.Rune) > 0 {
+			sb.WriteRune(re.Rune[0])
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteByte('a')
+	case syntax.OpCapture:
+		writeMatch(sb, re.Sub[0])
+	case syntax.OpPlus:
+		writeMatch(sb, re.Sub[0])
+	case syntax.OpRepeat:
+		for i := 0; i < re.Min; i++ {
+			writeMatch(sb, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writeMatch(sb, sub)
+		}
+	case syntax.OpAlternate:
+		writeMatch(sb, re.Sub[0])
+	}
+}
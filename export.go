@@ -0,0 +1,67 @@
+package check
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// Failure describes a failed check of an item of a batch.
+type Failure struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Failures returns the failed checks of the invalid items, in the order of
+// the items. The row of each failure is the index of its item.
+func (r *BatchResult) Failures() []Failure {
+	var failures []Failure
+	for _, idx := range r.Invalid() {
+		for _, err := range flattenErrors(r.Errors[idx]) {
+			e := toError(err)
+			failures = append(failures, Failure{
+				Row:     idx,
+				Field:   e.Field,
+				Code:    e.Code,
+				Message: e.Message,
+			})
+		}
+	}
+
+	return failures
+}
+
+// WriteCSV writes the failed checks of the batch to w in the CSV format,
+// with a `row,field,code,message` header.
+func (r *BatchResult) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"row", "field", "code", "message"}); err != nil {
+		return err
+	}
+
+	for _, f := range r.Failures() {
+		record := []string{strconv.Itoa(f.Row), f.Field, f.Code, f.Message}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteNDJSON writes the failed checks of the batch to w in the
+// newline-delimited JSON format, one failure per line.
+func (r *BatchResult) WriteNDJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, f := range r.Failures() {
+		if err := enc.Encode(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package check
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Files verifies paths using a file system.
+type Files struct {
+	// FS is the file system in which the paths are resolved. The paths
+	// must be valid fs.FS paths (e.g. `config/app.yaml`). If nil, the file
+	// system of the operating system is used, in which case the paths can
+	// be absolute or relative to the working directory.
+	FS fs.FS
+}
+
+var defaultFiles = &Files{}
+
+// FileExists checks if the path parameter refers to an existing regular
+// file, using the file system of the operating system.
+func FileExists(path string) ValidateFunc {
+	return defaultFiles.FileExists(path)
+}
+
+// DirExists checks if the path parameter refers to an existing directory,
+// using the file system of the operating system.
+func DirExists(path string) ValidateFunc {
+	return defaultFiles.DirExists(path)
+}
+
+// FileMaxSize checks if the path parameter refers to an existing regular
+// file of at most the specified number of bytes, using the file system of
+// the operating system.
+func FileMaxSize(path string, bytes int64) ValidateFunc {
+	return defaultFiles.FileMaxSize(path, bytes)
+}
+
+// FileExists checks if the path parameter refers to an existing regular
+// file.
+func (f *Files) FileExists(path string) ValidateFunc {
+	return func() error {
+		info, err := f.stat(path)
+		if err != nil {
+			return fileError(CodeFileExists, path, err, "file `%s` does not exist")
+		}
+		if !info.Mode().IsRegular() {
+			return newError(CodeFileExists, path, nil, "`%s` is not a regular file", path)
+		}
+
+		return nil
+	}
+}
+
+// DirExists checks if the path parameter refers to an existing directory.
+func (f *Files) DirExists(path string) ValidateFunc {
+	return func() error {
+		info, err := f.stat(path)
+		if err != nil {
+			return fileError(CodeDirExists, path, err, "directory `%s` does not exist")
+		}
+		if !info.IsDir() {
+			return newError(CodeDirExists, path, nil, "`%s` is not a directory", path)
+		}
+
+		return nil
+	}
+}
+
+// FileMaxSize checks if the path parameter refers to an existing regular
+// file of at most the specified number of bytes.
+func (f *Files) FileMaxSize(path string, bytes int64) ValidateFunc {
+	return func() error {
+		info, err := f.stat(path)
+		if err != nil {
+			return fileError(CodeFileExists, path, err, "file `%s` does not exist")
+		}
+		if !info.Mode().IsRegular() {
+			return newError(CodeFileExists, path, nil, "`%s` is not a regular file", path)
+		}
+		if info.Size() > bytes {
+			return newError(CodeFileSize, path, map[string]interface{}{"max": bytes},
+				"file `%s` is larger than `%d` bytes", path, bytes)
+		}
+
+		return nil
+	}
+}
+
+func (f *Files) stat(path string) (fs.FileInfo, error) {
+	if isEmptyStr(path) {
+		return nil, errors.New("empty path")
+	}
+	if f.FS == nil {
+		return os.Stat(path)
+	}
+
+	return fs.Stat(f.FS, path)
+}
+
+// PathWritable checks if the path parameter refers to a location which can
+// be written to, using the file system of the operating system. Existing
+// regular files must be writable and are opened for writing, without being
+// modified. Other existing files (e.g. named pipes or devices) are not
+// opened, as opening them can block or have side effects, and are reported
+// as not writable. For directories and paths which do not exist, the
+// directory must allow creating files, which is checked by creating and
+// removing a temporary file in it.
+func PathWritable(path string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(path) {
+			return requiredErr(true, "path cannot be empty")
+		}
+
+		dir := path
+		info, err := os.Stat(path)
+		switch {
+		case err == nil && info.Mode().IsRegular():
+			file, err := os.OpenFile(path, os.O_WRONLY, 0)
+			if err != nil {
+				return fileError(CodeWritable, path, err, "path `%s` is not writable")
+			}
+			return file.Close()
+		case err == nil && !info.IsDir():
+			return newError(CodeWritable, path, nil, "path `%s` is not a regular file or directory", path)
+		case errors.Is(err, fs.ErrNotExist):
+			dir = filepath.Dir(path)
+		case err != nil:
+			return fileError(CodeWritable, path, err, "path `%s` is not writable")
+		}
+
+		file, err := os.CreateTemp(dir, ".check-*")
+		if err != nil {
+			return fileError(CodeWritable, path, err, "path `%s` is not writable")
+		}
+		file.Close()
+
+		return os.Remove(file.Name())
+	}
+}
+
+// IsAbsPath checks if the path parameter is an absolute path, according to
+// the conventions of the operating system.
+func IsAbsPath(path string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(path) {
+			return requiredErr(true, "path cannot be empty")
+		}
+		if !filepath.IsAbs(path) {
+			return newError(CodeAbsPath, path, nil, "path `%s` is not absolute", path)
+		}
+
+		return nil
+	}
+}
+
+// HasExtension checks if the path parameter has one of the specified file
+// extensions. The extensions can be specified with or without the leading
+// dot (e.g. `.yaml`, `yml`), and are compared case-insensitively.
+func HasExtension(path string, exts ...string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(path) {
+			return requiredErr(true, "path cannot be empty")
+		}
+
+		ext := strings.TrimPrefix(filepath.Ext(path), ".")
+		for _, e := range exts {
+			if ext != "" && strings.EqualFold(ext, strings.TrimPrefix(e, ".")) {
+				return nil
+			}
+		}
+
+		return newError(CodeExtension, path, map[string]interface{}{"extensions": exts},
+			"path `%s` does not have one of the extensions %v", path, exts)
+	}
+}
+
+// fileError returns an error with the specified code, caused by the
+// underlying file system error err.
+func fileError(code, path string, err error, format string) error {
+	e := newError(code, path, nil, format, path)
+	e.Err = err
+	return e
+}
@@ -0,0 +1,338 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+const ellipsis = "..."
+
+// Limits restricts how much of the validated values is included in the
+// returned errors. The limits apply to the messages of the errors, to their
+// values and parameters and to the values passed to translators.
+type Limits struct {
+	// MaxLen is the maximum number of characters of a formatted value.
+	// Longer values are truncated and end with an ellipsis.
+	// A value of 0 means no limit.
+	MaxLen int
+
+	// MaxElems is the maximum number of elements of a formatted slice,
+	// array or map. The remaining elements are replaced by an ellipsis.
+	// A value of 0 means no limit.
+	MaxElems int
+}
+
+func (l Limits) isZero() bool {
+	return l.MaxLen <= 0 && l.MaxElems <= 0
+}
+
+// value returns x, truncated according to the limits (see truncate).
+func (l Limits) value(x interface{}) interface{} {
+	x, _ = l.truncate(x)
+	return x
+}
+
+// truncate truncates strings and byte slices to MaxLen characters or bytes,
+// truncated strings ending with an ellipsis, and slices, arrays and maps to
+// MaxElems elements. Other values are returned unchanged. The returned flag
+// reports whether x was truncated.
+func (l Limits) truncate(x interface{}) (interface{}, bool) {
+	switch v := x.(type) {
+	case string:
+		if l.MaxLen > 0 && utf8.RuneCountInString(v) > l.MaxLen {
+			return string([]rune(v)[:l.MaxLen]) + ellipsis, true
+		}
+		return x, false
+	case []byte:
+		if l.MaxLen > 0 && len(v) > l.MaxLen {
+			return v[:l.MaxLen], true
+		}
+		return x, false
+	}
+
+	if l.MaxElems > 0 {
+		return truncateElems(x, l.MaxElems)
+	}
+	return x, false
+}
+
+// params returns the parameters, truncated according to the limits. The
+// parameters are copied only if any of them is truncated.
+func (l Limits) params(params map[string]interface{}) map[string]interface{} {
+	var limited map[string]interface{}
+	for name, param := range params {
+		v, truncated := l.truncate(param)
+		if !truncated {
+			continue
+		}
+		if limited == nil {
+			limited = make(map[string]interface{}, len(params))
+			for name, param := range params {
+				limited[name] = param
+			}
+		}
+		limited[name] = v
+	}
+	if limited == nil {
+		return params
+	}
+
+	return limited
+}
+
+// Date and time layouts used for formatting time values, by locale.
+var dateLayouts = map[string]string{
+	"en":    "Jan 2, 2006 3:04 PM",
+	"en-GB": "2 Jan 2006 15:04",
+	"en-IE": "2 Jan 2006 15:04",
+	"en-AU": "2 Jan 2006 15:04",
+	"en-NZ": "2 Jan 2006 15:04",
+	"de":    "02.01.2006 15:04",
+	"da":    "02.01.2006 15:04",
+	"fi":    "2.1.2006 15:04",
+	"nb":    "02.01.2006 15:04",
+	"pl":    "02.01.2006 15:04",
+	"ro":    "02.01.2006 15:04",
+	"ru":    "02.01.2006 15:04",
+	"fr":    "02/01/2006 15:04",
+	"es":    "02/01/2006 15:04",
+	"it":    "02/01/2006 15:04",
+	"pt":    "02/01/2006 15:04",
+	"nl":    "02-01-2006 15:04",
+	"sv":    "2006-01-02 15:04",
+	"ja":    "2006/01/02 15:04",
+	"zh":    "2006/01/02 15:04",
+	"ko":    "2006. 01. 02. 15:04",
+}
+
+var (
+	dateLocales  []language.Tag
+	dateMatcher  language.Matcher
+	defaultDates = "2006-01-02 15:04"
+)
+
+func init() {
+	locales := make([]string, 0, len(dateLayouts))
+	for locale := range dateLayouts {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	for _, locale := range locales {
+		dateLocales = append(dateLocales, language.MustParse(locale))
+	}
+	dateMatcher = language.NewMatcher(append([]language.Tag{language.Und}, dateLocales...))
+}
+
+type formatting struct {
+	limits Limits
+	locale language.Tag
+}
+
+func (f formatting) isZero() bool {
+	return f.limits.isZero() && f.locale == language.Und
+}
+
+func (f formatting) override(o formatting) formatting {
+	if !o.limits.isZero() {
+		f.limits = o.limits
+	}
+	if o.locale != language.Und {
+		f.locale = o.locale
+	}
+
+	return f
+}
+
+var (
+	global   formatting
+	globalMu sync.RWMutex
+)
+
+// SetLimits sets the limits applied to the values included in error messages
+// by all validation functions. By default, values are not truncated.
+// Runners can override the global limits.
+func SetLimits(l Limits) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	global.limits = l
+}
+
+// SetLocale sets the locale used to format the values included in error
+// messages by all validation functions (e.g. `de-DE`). Numbers are formatted
+// using the separators of the locale, dates using its conventions and
+// currency amounts (currency.Amount values) using the symbol of the currency.
+// By default, or if locale is empty, values are not localized.
+// Runners can override the global locale.
+func SetLocale(locale string) error {
+	tag, err := parseLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	global.locale = tag
+	return nil
+}
+
+func globalFormatting() formatting {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+
+	return global
+}
+
+func parseLocale(locale string) (language.Tag, error) {
+	if locale == "" {
+		return language.Und, nil
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return language.Und, newError(CodeInvalid, locale, nil, "invalid locale `%s`", locale)
+	}
+
+	return tag, nil
+}
+
+func render(f formatting, format string, args ...interface{}) string {
+	if f.isZero() {
+		return fmt.Sprintf(format, args...)
+	}
+
+	formatted := make([]interface{}, len(args))
+	for i, arg := range args {
+		formatted[i] = formattedValue{value: arg, formatting: f}
+	}
+
+	return fmt.Sprintf(format, formatted...)
+}
+
+type formattedValue struct {
+	value      interface{}
+	formatting formatting
+}
+
+func (fv formattedValue) Format(f fmt.State, verb rune) {
+	str, ok := fv.localize(verb)
+	if !ok {
+		value, truncated := fv.value, false
+		if n := fv.formatting.limits.MaxLen; n > 0 {
+			// Truncate the value before formatting it, so that large values
+			// are not formatted in full only to be truncated afterwards.
+			value = truncateLen(value, n)
+		}
+		if n := fv.formatting.limits.MaxElems; n > 0 {
+			value, truncated = truncateElems(value, n)
+		}
+
+		str = fmt.Sprintf("%"+string(verb), value)
+		if truncated && str != "" {
+			// Insert the ellipsis before the closing bracket of the collection.
+			str = str[:len(str)-1] + " " + ellipsis + str[len(str)-1:]
+		}
+	}
+	if n := fv.formatting.limits.MaxLen; n > 0 && utf8.RuneCountInString(str) > n {
+		str = string([]rune(str)[:n]) + ellipsis
+	}
+
+	fmt.Fprint(f, str)
+}
+
+func (fv formattedValue) localize(verb rune) (string, bool) {
+	locale := fv.formatting.locale
+	if locale == language.Und || (verb != 'v' && verb != 'd') {
+		return "", false
+	}
+
+	switch v := fv.value.(type) {
+	case time.Time:
+		layout := defaultDates
+		if _, idx, conf := dateMatcher.Match(locale); conf != language.No && idx > 0 {
+			layout = dateLayouts[dateLocales[idx-1].String()]
+		}
+		return v.Format(layout), true
+	case currency.Amount:
+		return message.NewPrinter(locale).Sprint(currency.Symbol(v)), true
+	}
+
+	switch reflect.ValueOf(fv.value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, ok := fv.value.(fmt.Stringer); ok {
+			return "", false
+		}
+		return message.NewPrinter(locale).Sprint(number.Decimal(fv.value, number.MaxFractionDigits(15))), true
+	}
+
+	return "", false
+}
+
+// truncateLen truncates strings, byte slices and collections to the shortest
+// prefix which still formats to more than n characters, if they are longer.
+// Other values are returned unchanged.
+func truncateLen(x interface{}, n int) interface{} {
+	switch v := x.(type) {
+	case string:
+		if len(v) <= n {
+			return x
+		}
+		for i := range v {
+			if n < 0 {
+				return v[:i]
+			}
+			n--
+		}
+		return x
+	case []byte:
+		if len(v) <= n {
+			return x
+		}
+		return v[:n+1]
+	case fmt.Stringer, error:
+		return x
+	}
+
+	// Each element of a collection is formatted using at least one character.
+	x, _ = truncateElems(x, n+1)
+	return x
+}
+
+func truncateElems(x interface{}, n int) (interface{}, bool) {
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() <= n {
+			return x, false
+		}
+
+		s := reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), n, n)
+		reflect.Copy(s, v)
+		return s.Interface(), true
+	case reflect.Map:
+		if v.Len() <= n {
+			return x, false
+		}
+
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		for _, key := range sortedKeys(v)[:n] {
+			m.SetMapIndex(key, v.MapIndex(key))
+		}
+		return m.Interface(), true
+	}
+
+	return x, false
+}
@@ -0,0 +1,266 @@
+package check_test
+
+import (
+	"net"
+	"net/mail"
+	"net/url"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/adrg/check"
+)
+
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+func removeSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// fuzzOptional asserts that blank values are accepted by optional
+// validators and rejected by required ones.
+func fuzzOptional(t *testing.T, s string, fn func(s string, required bool) check.ValidateFunc) {
+	if !isBlank(s) {
+		return
+	}
+	if err := fn(s, false)(); err != nil {
+		t.Errorf("blank value %q rejected by optional validator: %v", s, err)
+	}
+	if err := fn(s, true)(); err == nil {
+		t.Errorf("blank value %q accepted by required validator", s)
+	}
+}
+
+func FuzzEmail(f *testing.F) {
+	for _, seed := range []string{"m@example.co.uk", "Bob<bob@example.com>", "", " ", "a@b", "@", "<>"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzOptional(t, s, check.Email)
+		if err := check.Email(s, true)(); err == nil {
+			if _, err := mail.ParseAddress(s); err != nil {
+				t.Errorf("accepted invalid email address %q", s)
+			}
+		}
+	})
+}
+
+func FuzzEmailList(f *testing.F) {
+	for _, seed := range []string{
+		"M <m@example.co.uk>, Q <q@example.co.uk>",
+		`"Bond, James" <james@example.co.uk>`,
+		",", ",,", " , ", "a@b,", "",
+	} {
+		f.Add(seed, "q@example.co.uk")
+	}
+
+	f.Fuzz(func(t *testing.T, list, email string) {
+		fuzzOptional(t, list, check.EmailList)
+		if check.EmailList(list, true)() != nil || check.Email(email, true)() != nil {
+			return
+		}
+		if strings.Contains(email, ",") {
+			return
+		}
+
+		// Appending a valid address to a valid list produces a valid list.
+		if err := check.EmailList(list+","+email, true)(); err != nil {
+			t.Errorf("rejected list %q extended with valid address %q: %v", list, email, err)
+		}
+	})
+}
+
+func FuzzIBAN(f *testing.F) {
+	for _, seed := range []string{
+		"GB82 WEST 1234 5698 7654 32", "IE64IRCE92050112345678", "DE89370400440532013000",
+		"GB82", "", "  ", "ZZ00", "gb82west12345698765432", "GB82WEST1234569876543é",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzOptional(t, s, check.IBAN)
+		if err := check.IBAN(s, true)(); err != nil {
+			return
+		}
+
+		normalized := strings.ToUpper(removeSpaces(s))
+		if n := len(normalized); n < 15 || n > 34 {
+			t.Errorf("accepted IBAN %q of invalid length %d", s, n)
+		}
+		if err := check.IBAN(normalized, true)(); err != nil {
+			t.Errorf("rejected normalized form %q of valid IBAN %q: %v", normalized, s, err)
+		}
+	})
+}
+
+func FuzzURL(f *testing.F) {
+	for _, seed := range []string{
+		"https://bond.example.com", "https://example com", "test@example", "http://[::1]:8080/",
+		"https://:443", "mailto:q@example.co.uk", "", "%", "http://a b",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzOptional(t, s, check.URL)
+		if err := check.URLWith(s, check.URLOptions{RequireHost: true})(); err != nil {
+			return
+		}
+
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" || u.Hostname() == "" {
+			t.Errorf("accepted invalid URL %q", s)
+		}
+	})
+}
+
+func FuzzMAC(f *testing.F) {
+	for _, seed := range []string{"A3:4D:7A:8A:50:B8", "a3-4d-7a-8a-50-b8", "0000.5e00.5301", "", "::::::"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzOptional(t, s, check.MAC)
+		if isBlank(s) {
+			return
+		}
+
+		_, parseErr := net.ParseMAC(s)
+		if err := check.MAC(s, true)(); (err == nil) != (parseErr == nil) {
+			t.Errorf("MAC(%q) = %v, net.ParseMAC error = %v", s, err, parseErr)
+		}
+	})
+}
+
+func FuzzPhone(f *testing.F) {
+	for _, seed := range []string{"+442079460958", "+1 (212) 555-0100", "020 7946 0958", "+", "", "++1"} {
+		f.Add(seed, "GB")
+	}
+
+	f.Fuzz(func(t *testing.T, s, region string) {
+		fuzzOptional(t, s, check.Phone)
+		if err := check.Phone(s, true)(); err == nil && !strings.HasPrefix(strings.TrimSpace(s), "+") {
+			t.Errorf("accepted phone number %q without calling code", s)
+		}
+		if isBlank(s) {
+			return
+		}
+		_ = check.PhoneRegion(s, region, true)()
+	})
+}
+
+func FuzzCreditCard(f *testing.F) {
+	for _, seed := range []string{"4111 1111 1111 1111", "5500-0000-0000-0004", "4111111111111112", "", "0"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if isBlank(s) {
+			return
+		}
+		if err := check.CreditCard(s, true)(); err != nil {
+			return
+		}
+
+		var digits int
+		for _, r := range s {
+			if r >= '0' && r <= '9' {
+				digits++
+			}
+		}
+		if digits < 12 || digits > 19 {
+			t.Errorf("accepted card number %q with %d digits", s, digits)
+		}
+	})
+}
+
+func FuzzIdentifiers(f *testing.F) {
+	for _, seed := range []string{
+		"DEUTDEFF500", "ATU00000024", "DE136695976", "FR40303265045", "ESX1234567L",
+		"0f8fad5b-d9cb-469f-a165-70867728950e", "pt-BR", "DEU", "", "\x00",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		validators := map[string]func(s string, required bool) check.ValidateFunc{
+			"BIC":          check.BIC,
+			"VAT":          check.VAT,
+			"UUID":         check.UUID,
+			"IP":           check.IP,
+			"PublicIP":     check.PublicIP,
+			"CurrencyCode": check.CurrencyCode,
+			"LanguageCode": check.LanguageCode,
+			"Alphanumeric": check.Alphanumeric,
+		}
+		for name, fn := range validators {
+			t.Run(name, func(t *testing.T) {
+				fuzzOptional(t, s, fn)
+				_ = fn(s, true)()
+			})
+		}
+
+		for _, country := range []string{"DE", "FR", "ES", "GB", "NL", "GR"} {
+			if !isBlank(s) {
+				_ = check.VATCountry(s, country, true)()
+			}
+		}
+	})
+}
+
+func FuzzRequired(f *testing.F) {
+	for i, seed := range []string{"", " ", "007", "\x00"} {
+		f.Add(seed, uint8(i))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, depth uint8) {
+		p := &s
+		pp := &p
+		var i interface{} = pp
+
+		// Pointers and interfaces are empty if the values they reference
+		// are empty.
+		for _, x := range []interface{}{s, p, pp, i} {
+			if err := check.Required(x)(); (err != nil) != (s == "") {
+				t.Errorf("Required(%#v) = %v for value %q", x, err, s)
+			}
+		}
+
+		// Chains of pointers and interfaces ending with a nil pointer or
+		// interface are empty, regardless of their types and lengths.
+		var x interface{}
+		switch depth % 4 {
+		case 1:
+			x = (*string)(nil)
+		case 2:
+			var p *string
+			x = &p
+		case 3:
+			var pp **string
+			x = &pp
+		}
+		for j := 0; j < int(depth/4%4); j++ {
+			v := x
+			x = &v
+		}
+		if err := check.Required(x)(); err == nil {
+			t.Errorf("Required(%#v) = nil for nil chain %d", x, depth)
+		}
+
+		// Collections are only empty if they have no elements.
+		for _, x := range []interface{}{[]*string{p}, map[string]**string{s: pp}, []*string{nil}} {
+			if err := check.Required(x)(); err != nil {
+				t.Errorf("Required(%#v) = %v for value %q", x, err, s)
+			}
+		}
+	})
+}
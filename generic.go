@@ -0,0 +1,121 @@
+package check
+
+import "cmp"
+
+// EqT checks if x is equal to the comparison term. Unlike Eq, the type of
+// the arguments is checked at compile time and no reflection is used.
+func EqT[T comparable](x, term T) ValidateFunc {
+	return func() error {
+		if x != term {
+			return cmpError(eq, x, term)
+		}
+
+		return nil
+	}
+}
+
+// NeT checks if x is not equal to the comparison term. Unlike Ne, the type
+// of the arguments is checked at compile time and no reflection is used.
+func NeT[T comparable](x, term T) ValidateFunc {
+	return func() error {
+		if x == term {
+			return cmpError(ne, x, term)
+		}
+
+		return nil
+	}
+}
+
+// LtT checks if x is less than the comparison term. Unlike Lt, the type of
+// the arguments is checked at compile time and no reflection is used.
+func LtT[T cmp.Ordered](x, term T) ValidateFunc {
+	return func() error {
+		if !(x < term) {
+			return cmpError(lt, x, term)
+		}
+
+		return nil
+	}
+}
+
+// LteT checks if x is less than or equal to the comparison term. Unlike Lte,
+// the type of the arguments is checked at compile time and no reflection
+// is used.
+func LteT[T cmp.Ordered](x, term T) ValidateFunc {
+	return func() error {
+		if !(x <= term) {
+			return cmpError(lte, x, term)
+		}
+
+		return nil
+	}
+}
+
+// GtT checks if x is greater than the comparison term. Unlike Gt, the type
+// of the arguments is checked at compile time and no reflection is used.
+func GtT[T cmp.Ordered](x, term T) ValidateFunc {
+	return func() error {
+		if !(x > term) {
+			return cmpError(gt, x, term)
+		}
+
+		return nil
+	}
+}
+
+// GteT checks if x is greater than or equal to the comparison term. Unlike
+// Gte, the type of the arguments is checked at compile time and no
+// reflection is used.
+func GteT[T cmp.Ordered](x, term T) ValidateFunc {
+	return func() error {
+		if !(x >= term) {
+			return cmpError(gte, x, term)
+		}
+
+		return nil
+	}
+}
+
+// BetweenT checks if x is greater than or equal to the lower bound and less
+// than or equal to the upper bound. Unlike Between, the type of the arguments
+// is checked at compile time and no reflection is used.
+func BetweenT[T cmp.Ordered](x, lower, upper T) ValidateFunc {
+	return func() error {
+		if err := GteT(x, lower)(); err != nil {
+			return err
+		}
+
+		return LteT(x, upper)()
+	}
+}
+
+// InT verifies that x is equal to one of the elems values. Unlike In, the
+// type of the arguments is checked at compile time and no reflection is used.
+func InT[T comparable](x T, elems ...T) ValidateFunc {
+	return func() error {
+		for _, elem := range elems {
+			if x == elem {
+				return nil
+			}
+		}
+
+		return newError(CodeIn, x, map[string]interface{}{"elems": elems},
+			"`in` comparison failed: `%v` not in `%v`", x, elems)
+	}
+}
+
+// NotInT verifies that x is not equal to any of the elems values. Unlike
+// NotIn, the type of the arguments is checked at compile time and no
+// reflection is used.
+func NotInT[T comparable](x T, elems ...T) ValidateFunc {
+	return func() error {
+		for _, elem := range elems {
+			if x == elem {
+				return newError(CodeNotIn, x, map[string]interface{}{"elems": elems},
+					"`not in` comparison failed: `%v` in `%v`", x, elems)
+			}
+		}
+
+		return nil
+	}
+}
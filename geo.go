@@ -0,0 +1,86 @@
+package check
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Latitude checks if v is a valid latitude, between -90 and 90 degrees.
+// The latitude can be a number or a string containing a decimal number.
+func Latitude(v interface{}) ValidateFunc {
+	return coordValidator(v, 90, CodeLatitude, "latitude")
+}
+
+// Longitude checks if v is a valid longitude, between -180 and 180 degrees.
+// The longitude can be a number or a string containing a decimal number.
+func Longitude(v interface{}) ValidateFunc {
+	return coordValidator(v, 180, CodeLongitude, "longitude")
+}
+
+// LatLong checks if the s parameter is a valid pair of coordinates, in the
+// `latitude,longitude` format (e.g. `51.5072,-0.1276`). Spaces around the
+// coordinates are ignored.
+func LatLong(s string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(true, "coordinates cannot be empty")
+		}
+
+		lat, long, ok := strings.Cut(s, ",")
+		if !ok {
+			return newError(CodeLatLong, s, nil, "invalid coordinates `%s`", s)
+		}
+		if err := Latitude(lat)(); err != nil {
+			return err
+		}
+
+		return Longitude(long)()
+	}
+}
+
+func coordValidator(v interface{}, max float64, code, name string) ValidateFunc {
+	return func() error {
+		x, ok := coordValue(v)
+		if !ok {
+			return newError(code, v, nil, "invalid %s `%v`", name, v)
+		}
+		if x < -max || x > max {
+			return newError(code, v, map[string]interface{}{"min": -max, "max": max},
+				"%s `%v` is not between `%v` and `%v`", name, v, -max, max)
+		}
+
+		return nil
+	}
+}
+
+// coordValue converts the coordinate v, which can be a number or a string
+// containing a decimal number, to a float64.
+func coordValue(v interface{}) (float64, bool) {
+	rv := reflect.ValueOf(v)
+
+	var x float64
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x = float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		x = float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		x = rv.Float()
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if s == "" || strings.ContainsAny(s, "xXeEpP_") {
+			return 0, false
+		}
+
+		var err error
+		if x, err = strconv.ParseFloat(s, 64); err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	return x, !math.IsNaN(x) && !math.IsInf(x, 0)
+}
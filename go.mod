@@ -1 +1,7 @@
 module github.com/adrg/check
+
+go 1.21
+
+require golang.org/x/text v0.21.0
+
+require gopkg.in/yaml.v3 v3.0.1
@@ -0,0 +1,89 @@
+package check
+
+import (
+	"sort"
+	"strings"
+)
+
+// Acyclic checks if the directed graph described by edges does not contain
+// cycles. Each key of the map is a node and its values are the nodes it
+// depends on. The returned error contains the path of the detected cycle.
+func Acyclic(edges map[string][]string) ValidateFunc {
+	return func() error {
+		nodes := make([]string, 0, len(edges))
+		for node := range edges {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+
+		const (
+			unvisited = iota
+			visiting
+			visited
+		)
+		state := map[string]int{}
+
+		var path []string
+		var visit func(node string) []string
+		visit = func(node string) []string {
+			switch state[node] {
+			case visiting:
+				for i, n := range path {
+					if n == node {
+						return append(append([]string{}, path[i:]...), node)
+					}
+				}
+			case visited:
+				return nil
+			}
+
+			state[node] = visiting
+			path = append(path, node)
+			for _, next := range edges[node] {
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+			path = path[:len(path)-1]
+			state[node] = visited
+
+			return nil
+		}
+
+		for _, node := range nodes {
+			if cycle := visit(node); cycle != nil {
+				return newError(CodeCycle, edges, map[string]interface{}{"cycle": cycle},
+					"dependency cycle detected: `%s`", strings.Join(cycle, " -> "))
+			}
+		}
+
+		return nil
+	}
+}
+
+// ReferencesExist checks if all the references are present in the set of
+// known values. The returned error contains the missing references.
+func ReferencesExist(refs []string, known map[string]struct{}) ValidateFunc {
+	return func() error {
+		var missing []string
+		seen := map[string]struct{}{}
+		for _, ref := range refs {
+			if _, ok := known[ref]; ok {
+				continue
+			}
+			if _, ok := seen[ref]; ok {
+				continue
+			}
+
+			seen[ref] = struct{}{}
+			missing = append(missing, ref)
+		}
+
+		if len(missing) > 0 {
+			return newError(CodeReference, refs, map[string]interface{}{"missing": missing},
+				"unknown references `%v`", missing)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,38 @@
+package check
+
+// Extensions returns the GraphQL error extensions of the error, containing
+// its code, field and parameters. Errors which implement this method are
+// recognized by GraphQL libraries (e.g. gqlgen), so validation errors can be
+// returned directly by resolvers.
+func (e *Error) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{
+		"code": e.Code,
+	}
+	if e.Field != "" {
+		ext["field"] = e.Field
+	}
+	if len(e.Params) > 0 {
+		ext["params"] = e.Params
+	}
+
+	return ext
+}
+
+// Extensions returns the GraphQL error extensions of the errors in the list,
+// containing the CodeInvalid code and the extensions of each error, along
+// with its message.
+func (errs Errors) Extensions() map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(errs))
+	for _, err := range flattenErrors(errs) {
+		e := toError(err)
+
+		ext := e.Extensions()
+		ext["message"] = e.Message
+		list = append(list, ext)
+	}
+
+	return map[string]interface{}{
+		"code":   CodeInvalid,
+		"errors": list,
+	}
+}
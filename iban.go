@@ -0,0 +1,142 @@
+package check
+
+import (
+	"strings"
+)
+
+// BBAN formats of the countries using IBANs, as specified by the IBAN
+// registry. Each segment consists of a length and a character type:
+// n (digits), a (uppercase letters) or c (digits and uppercase letters).
+var ibanFormats = map[string]string{
+	"AD": "4n4n12c", "AE": "3n16n", "AL": "8n16c", "AT": "16n",
+	"AZ": "4a20c", "BA": "16n", "BE": "12n", "BG": "4a4n2n8c",
+	"BH": "4a14c", "BI": "5n5n11n2n", "BR": "8n5n10n1a1c", "BY": "4c4n16c",
+	"CH": "5n12c", "CR": "18n", "CY": "3n5n16c", "CZ": "20n",
+	"DE": "18n", "DJ": "5n5n11n2n", "DK": "14n", "DO": "4c20n",
+	"EE": "16n", "EG": "25n", "ES": "20n", "FI": "14n",
+	"FK": "2a12n", "FO": "14n", "FR": "10n11c2n", "GB": "4a14n",
+	"GE": "2a16n", "GI": "4a15c", "GL": "14n", "GR": "7n16c",
+	"GT": "4c20c", "HN": "4a20n", "HR": "17n", "HU": "24n",
+	"IE": "4a14n", "IL": "19n", "IQ": "4a15n", "IS": "22n",
+	"IT": "1a10n12c", "JO": "4a4n18c", "KW": "4a22c", "KZ": "3n13c",
+	"LB": "4n20c", "LC": "4a24c", "LI": "5n12c", "LT": "16n",
+	"LU": "3n13c", "LV": "4a13c", "LY": "3n3n15n", "MC": "10n11c2n",
+	"MD": "2c18c", "ME": "18n", "MK": "3n10c2n", "MN": "4n12n",
+	"MR": "23n", "MT": "4a5n18c", "MU": "4a19n3a", "NI": "4a20n",
+	"NL": "4a10n", "NO": "11n", "OM": "3n16c", "PK": "4a16c",
+	"PL": "24n", "PS": "4a21c", "PT": "21n", "QA": "4a21c",
+	"RO": "4a16c", "RS": "18n", "RU": "9n5n15c", "SA": "2n18c",
+	"SC": "4a20n3a", "SD": "2n12n", "SE": "20n", "SI": "15n",
+	"SK": "20n", "SM": "1a10n12c", "SO": "4n3n12n", "ST": "21n",
+	"SV": "4a20n", "TL": "19n", "TN": "20n", "TR": "5n1n16c",
+	"UA": "6n19c", "VA": "18n", "VG": "4a16n", "XK": "16n",
+	"YE": "4a4n18c",
+}
+
+var ibanPatterns = compileIBANFormats(ibanFormats)
+
+func compileIBANFormats(formats map[string]string) map[string]*lazyRegexp {
+	classes := map[byte]string{'n': "[0-9]", 'a': "[A-Z]", 'c': "[A-Z0-9]"}
+
+	patterns := make(map[string]*lazyRegexp, len(formats))
+	for country, format := range formats {
+		var pattern strings.Builder
+		pattern.WriteString("^" + country + "[0-9]{2}")
+
+		var start int
+		for i := 0; i < len(format); i++ {
+			class, ok := classes[format[i]]
+			if !ok {
+				continue
+			}
+
+			pattern.WriteString(class + "{" + format[start:i] + "}")
+			start = i + 1
+		}
+		pattern.WriteString("$")
+
+		patterns[country] = newLazyRegexp(pattern.String())
+	}
+
+	return patterns
+}
+
+// IBAN checks if the iban parameter is a valid IBAN. Whitespace is ignored.
+// The length and format of the IBAN are checked using the rules of its
+// country and the check digits are verified using the ISO 7064 mod-97-10
+// algorithm. The IBAN can be empty if the required parameter is false.
+func IBAN(iban string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(iban) {
+			return requiredErr(required, "IBAN cannot be empty")
+		}
+
+		normalized := strings.ToUpper(stripSpaces(iban))
+		if len(normalized) < 4 {
+			return newError(CodeIBAN, iban, nil, "invalid IBAN `%s`", iban)
+		}
+
+		country := normalized[:2]
+		pattern, ok := ibanPatterns[country]
+		if !ok {
+			return newError(CodeIBAN, iban, map[string]interface{}{"country": country},
+				"invalid IBAN `%s`", iban)
+		}
+		if !pattern.MatchString(normalized) || !ibanChecksum(normalized) {
+			return newError(CodeIBAN, iban, map[string]interface{}{"country": country},
+				"invalid IBAN `%s`", iban)
+		}
+
+		return nil
+	}
+}
+
+// BIC checks if the code parameter is a valid BIC (SWIFT code). The code must
+// consist of 8 or 11 uppercase letters and digits and contain a valid
+// ISO 3166-1 country code. The code can be empty if the required parameter
+// is false.
+func BIC(code string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(code) {
+			return requiredErr(required, "BIC cannot be empty")
+		}
+
+		m := regBIC.FindStringSubmatch(code)
+		if m == nil {
+			return newError(CodeBIC, code, nil, "invalid BIC `%s`", code)
+		}
+		// Kosovo uses the user-assigned XK code.
+		if _, ok := countryCodes[m[1]]; !ok && m[1] != "XK" {
+			return newError(CodeBIC, code, map[string]interface{}{"country": m[1]},
+				"invalid BIC `%s`", code)
+		}
+
+		return nil
+	}
+}
+
+// ibanChecksum verifies the check digits of the IBAN. The first four
+// characters are moved to the end and the resulting number must have a
+// remainder of 1 when divided by 97.
+func ibanChecksum(iban string) bool {
+	rem, ok := mod97(iban[4:] + iban[:4])
+	return ok && rem == 1
+}
+
+// mod97 returns the remainder of the division by 97 of the number obtained
+// by replacing the letters of s by two digit numbers (A = 10, ..., Z = 35).
+func mod97(s string) (int, bool) {
+	var rem int
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			rem = (rem*10 + int(r-'0')) % 97
+		case r >= 'A' && r <= 'Z':
+			rem = (rem*100 + int(r-'A') + 10) % 97
+		default:
+			return 0, false
+		}
+	}
+
+	return rem, true
+}
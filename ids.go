@@ -0,0 +1,214 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultKeyMinLen  = 1
+	defaultKeyMaxLen  = 255
+	defaultKeyCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+	defaultNanoIDLen      = 21
+	defaultNanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+	// maxKSUID is the encoding of the largest 20 byte value. As the base62
+	// alphabet is in ASCII order, KSUIDs can be compared lexically.
+	maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+// IdempotencyKeyOptions contains the constraints applied by the
+// IdempotencyKey validator.
+type IdempotencyKeyOptions struct {
+	// MinLen is the minimum length of the key. Defaults to 1.
+	MinLen int
+
+	// MaxLen is the maximum length of the key. Defaults to 255.
+	MaxLen int
+
+	// Charset contains the characters allowed in the key.
+	// Defaults to ASCII letters, digits, `-` and `_`.
+	Charset string
+
+	// UUID allows keys formatted as UUIDs. If UUID or ULID are set, the key
+	// must be in one of the enabled formats, and the length and charset
+	// constraints are ignored.
+	UUID bool
+
+	// ULID allows keys formatted as ULIDs. If UUID or ULID are set, the key
+	// must be in one of the enabled formats, and the length and charset
+	// constraints are ignored.
+	ULID bool
+}
+
+// IdempotencyKey checks if the key parameter is a valid idempotency key,
+// based on the specified options.
+func IdempotencyKey(key string, opts IdempotencyKeyOptions) ValidateFunc {
+	return func() error {
+		if isEmptyStr(key) {
+			return requiredErr(true, "idempotency key cannot be empty")
+		}
+
+		if opts.UUID || opts.ULID {
+			if (opts.UUID && regUUID.MatchString(key)) || (opts.ULID && regULID.MatchString(key)) {
+				return nil
+			}
+			return newError(CodeIdempotencyKey, key, nil, "invalid idempotency key `%s`", key)
+		}
+
+		minLen, maxLen, charset := opts.MinLen, opts.MaxLen, opts.Charset
+		if minLen <= 0 {
+			minLen = defaultKeyMinLen
+		}
+		if maxLen <= 0 {
+			maxLen = defaultKeyMaxLen
+		}
+		if charset == "" {
+			charset = defaultKeyCharset
+		}
+
+		if n := utf8.RuneCountInString(key); n < minLen || n > maxLen {
+			return newError(CodeIdempotencyKey, key, map[string]interface{}{
+				"min": minLen,
+				"max": maxLen,
+			}, "idempotency key length must be between `%d` and `%d`", minLen, maxLen)
+		}
+		for _, r := range key {
+			if !strings.ContainsRune(charset, r) {
+				return newError(CodeIdempotencyKey, key, map[string]interface{}{"charset": charset},
+					"invalid character `%c` in idempotency key", r)
+			}
+		}
+
+		return nil
+	}
+}
+
+// RequestID checks if the id parameter is a valid request ID. A request ID
+// must contain between 1 and 128 ASCII letters, digits, `.`, `_`, `:` or `-`.
+func RequestID(id string) ValidateFunc {
+	return func() error {
+		if isEmptyStr(id) {
+			return requiredErr(true, "request ID cannot be empty")
+		}
+		if ok := regRequestID.MatchString(id); !ok {
+			return newError(CodeRequestID, id, nil, "invalid request ID `%s`", id)
+		}
+
+		return nil
+	}
+}
+
+// UUID checks if the uuid parameter is a valid RFC 4122 UUID, of any version.
+// The UUID can be empty if the required parameter is false.
+func UUID(uuid string, required bool) ValidateFunc {
+	return UUIDVersion(uuid, 0, required)
+}
+
+// UUIDv4 checks if the uuid parameter is a valid version 4 UUID.
+// The UUID can be empty if the required parameter is false.
+func UUIDv4(uuid string, required bool) ValidateFunc {
+	return UUIDVersion(uuid, 4, required)
+}
+
+// UUIDv7 checks if the uuid parameter is a valid version 7 UUID.
+// The UUID can be empty if the required parameter is false.
+func UUIDv7(uuid string, required bool) ValidateFunc {
+	return UUIDVersion(uuid, 7, required)
+}
+
+// UUIDVersion checks if the uuid parameter is a valid RFC 4122 UUID of the
+// specified version. If version is 0, any version between 1 and 8 is accepted.
+// The UUID can be empty if the required parameter is false.
+func UUIDVersion(uuid string, version int, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(uuid) {
+			return requiredErr(required, "UUID cannot be empty")
+		}
+		if ok := regUUID.MatchString(uuid); !ok {
+			return newError(CodeUUID, uuid, nil, "invalid UUID `%s`", uuid)
+		}
+
+		// The version is stored in the 13th hex digit, while the variant is
+		// stored in the most significant bits of the 17th hex digit.
+		v, err := strconv.ParseUint(uuid[14:15], 16, 8)
+		if err != nil || v < 1 || v > 8 {
+			return newError(CodeUUID, uuid, nil, "invalid UUID `%s`", uuid)
+		}
+		if !strings.ContainsRune("89abAB", rune(uuid[19])) {
+			return newError(CodeUUID, uuid, nil, "invalid UUID variant `%s`", uuid)
+		}
+		if version != 0 && int(v) != version {
+			return newError(CodeUUID, uuid, map[string]interface{}{"version": version},
+				"UUID `%s` is not version `%d`", uuid, version)
+		}
+
+		return nil
+	}
+}
+
+// ULID checks if the ulid parameter is a valid ULID, consisting of 26
+// characters of Crockford's base32 alphabet. The ULID can be empty if the
+// required parameter is false.
+func ULID(ulid string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(ulid) {
+			return requiredErr(required, "ULID cannot be empty")
+		}
+		if ok := regULID.MatchString(ulid); !ok {
+			return newError(CodeULID, ulid, nil, "invalid ULID `%s`", ulid)
+		}
+
+		return nil
+	}
+}
+
+// KSUID checks if the ksuid parameter is a valid KSUID, consisting of 27
+// base62 characters which encode a 20 byte value. The KSUID can be empty if
+// the required parameter is false.
+func KSUID(ksuid string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(ksuid) {
+			return requiredErr(required, "KSUID cannot be empty")
+		}
+		if ok := regKSUID.MatchString(ksuid); !ok || ksuid > maxKSUID {
+			return newError(CodeKSUID, ksuid, nil, "invalid KSUID `%s`", ksuid)
+		}
+
+		return nil
+	}
+}
+
+// NanoID checks if the id parameter is a valid NanoID of the specified
+// length, containing only characters of the specified alphabet. If length
+// is not positive, the default length of 21 is used. If alphabet is empty,
+// the default URL-safe alphabet is used.
+func NanoID(id string, length int, alphabet string) ValidateFunc {
+	if length <= 0 {
+		length = defaultNanoIDLen
+	}
+	if alphabet == "" {
+		alphabet = defaultNanoIDAlphabet
+	}
+
+	return func() error {
+		if isEmptyStr(id) {
+			return requiredErr(true, "NanoID cannot be empty")
+		}
+
+		if n := utf8.RuneCountInString(id); n != length {
+			return newError(CodeNanoID, id, map[string]interface{}{"len": length},
+				"NanoID `%s` must contain `%d` characters", id, length)
+		}
+		for _, r := range id {
+			if !strings.ContainsRune(alphabet, r) {
+				return newError(CodeNanoID, id, map[string]interface{}{"alphabet": alphabet},
+					"invalid character `%c` in NanoID `%s`", r, id)
+			}
+		}
+
+		return nil
+	}
+}
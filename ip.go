@@ -0,0 +1,117 @@
+package check
+
+import "net"
+
+// Special-purpose IPv4 and IPv6 ranges which are not globally reachable,
+// in addition to the private, loopback, link-local, multicast and
+// unspecified addresses.
+var reservedNets = parseCIDRs(
+	"0.0.0.0/8",       // "this" network
+	"100.64.0.0/10",   // shared address space (carrier-grade NAT)
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // documentation (TEST-NET-1)
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // documentation (TEST-NET-2)
+	"203.0.113.0/24",  // documentation (TEST-NET-3)
+	"240.0.0.0/4",     // reserved
+	"64:ff9b:1::/48",  // local-use IPv4/IPv6 translation
+	"100::/64",        // discard-only
+	"2001::/23",       // IETF protocol assignments
+	"2001:db8::/32",   // documentation
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, nets[i], _ = net.ParseCIDR(cidr)
+	}
+
+	return nets
+}
+
+func parseIP(ip string) (net.IP, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, newError(CodeIP, ip, nil, "invalid IP address `%s`", ip)
+	}
+
+	return addr, nil
+}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()
+}
+
+func isPublicIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() || isPrivateIP(ip) {
+		return false
+	}
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ipValidator returns a validation function which checks if the ip
+// parameter is a valid IP address for which the predicate returns true.
+func ipValidator(ip string, required bool, code, format string, pred func(net.IP) bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(ip) {
+			return requiredErr(required, "IP address cannot be empty")
+		}
+
+		addr, err := parseIP(ip)
+		if err != nil {
+			return err
+		}
+		if !pred(addr) {
+			return newError(code, ip, nil, format, ip)
+		}
+
+		return nil
+	}
+}
+
+// PrivateIP checks if the ip parameter is an IP address in a private
+// (RFC 1918, RFC 4193) or link-local range. The IP address can be empty
+// if the required parameter is false.
+func PrivateIP(ip string, required bool) ValidateFunc {
+	return ipValidator(ip, required, CodePrivateIP, "`%s` is not a private IP address", isPrivateIP)
+}
+
+// NotPrivateIP checks if the ip parameter is an IP address which is not in
+// a private (RFC 1918, RFC 4193) or link-local range. The IP address can be
+// empty if the required parameter is false.
+func NotPrivateIP(ip string, required bool) ValidateFunc {
+	return ipValidator(ip, required, CodeNotPrivateIP, "`%s` is a private IP address", func(addr net.IP) bool {
+		return !isPrivateIP(addr)
+	})
+}
+
+// PublicIP checks if the ip parameter is a globally reachable IP address.
+// Private, link-local, loopback, multicast, unspecified and other
+// special-purpose addresses (e.g. carrier-grade NAT, documentation) are
+// rejected, which makes the check suitable for guarding outbound requests
+// to user-provided addresses. The IP address can be empty if the required
+// parameter is false.
+func PublicIP(ip string, required bool) ValidateFunc {
+	return ipValidator(ip, required, CodePublicIP, "`%s` is not a public IP address", isPublicIP)
+}
+
+// LoopbackIP checks if the ip parameter is a loopback IP address.
+// The IP address can be empty if the required parameter is false.
+func LoopbackIP(ip string, required bool) ValidateFunc {
+	return ipValidator(ip, required, CodeLoopbackIP, "`%s` is not a loopback IP address", net.IP.IsLoopback)
+}
+
+// NotLoopbackIP checks if the ip parameter is an IP address which is not
+// a loopback address. The IP address can be empty if the required parameter
+// is false.
+func NotLoopbackIP(ip string, required bool) ValidateFunc {
+	return ipValidator(ip, required, CodeNotLoopbackIP, "`%s` is a loopback IP address", func(addr net.IP) bool {
+		return !addr.IsLoopback()
+	})
+}
@@ -0,0 +1,77 @@
+package check
+
+// Lazy defers the creation of a validation function until it is executed.
+// It can be used to defer the evaluation of the arguments of any validator
+// (e.g. when they reference fields of structs which might be nil):
+//
+//	check.Lazy(func() check.ValidateFunc {
+//		return check.Email(user.Profile.Email, true)
+//	})
+func Lazy(fn func() ValidateFunc) ValidateFunc {
+	return func() error {
+		return fn()()
+	}
+}
+
+// RequiredFn checks if any of the values returned by the providers is
+// empty (see Required). The providers are called when the validation
+// function is executed, in order, until the first empty value.
+func RequiredFn(providers ...func() interface{}) ValidateFunc {
+	return func() error {
+		for _, provider := range providers {
+			if err := Required(provider())(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// LazyEq checks if x is equal to the comparison term (see Eq). The values
+// are returned by the provider, which is called when the validation
+// function is executed.
+func LazyEq(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Eq, provider)
+}
+
+// LazyNe checks if x is not equal to the comparison term (see Ne). The
+// values are returned by the provider, which is called when the validation
+// function is executed.
+func LazyNe(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Ne, provider)
+}
+
+// LazyLt checks if x is less than the comparison term (see Lt). The values
+// are returned by the provider, which is called when the validation
+// function is executed.
+func LazyLt(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Lt, provider)
+}
+
+// LazyLte checks if x is less than or equal to the comparison term (see
+// Lte). The values are returned by the provider, which is called when the
+// validation function is executed.
+func LazyLte(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Lte, provider)
+}
+
+// LazyGt checks if x is greater than the comparison term (see Gt). The
+// values are returned by the provider, which is called when the validation
+// function is executed.
+func LazyGt(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Gt, provider)
+}
+
+// LazyGte checks if x is greater than or equal to the comparison term (see
+// Gte). The values are returned by the provider, which is called when the
+// validation function is executed.
+func LazyGte(provider func() (x, term interface{})) ValidateFunc {
+	return lazyCmp(Gte, provider)
+}
+
+func lazyCmp(fn func(x, term interface{}) ValidateFunc, provider func() (x, term interface{})) ValidateFunc {
+	return func() error {
+		return fn(provider())()
+	}
+}
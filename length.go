@@ -0,0 +1,91 @@
+package check
+
+import (
+	"reflect"
+	"unicode/utf8"
+)
+
+// Len checks if the length of x is equal to n. The length of strings is
+// computed in runes. Should be used for strings, slices, arrays, maps
+// or channels.
+func Len(x interface{}, n int) ValidateFunc {
+	return func() error {
+		l, err := length(x)
+		if err != nil {
+			return err
+		}
+		if l != n {
+			return newError(CodeLen, x, map[string]interface{}{"len": n},
+				"length of `%v` is `%d`, not `%d`", x, l, n)
+		}
+
+		return nil
+	}
+}
+
+// MinLen checks if the length of x is greater than or equal to n. The
+// length of strings is computed in runes. Should be used for strings,
+// slices, arrays, maps or channels.
+func MinLen(x interface{}, n int) ValidateFunc {
+	return func() error {
+		l, err := length(x)
+		if err != nil {
+			return err
+		}
+		if l < n {
+			return newError(CodeMinLen, x, map[string]interface{}{"min": n},
+				"length of `%v` is `%d`, less than `%d`", x, l, n)
+		}
+
+		return nil
+	}
+}
+
+// MaxLen checks if the length of x is less than or equal to n. The
+// length of strings is computed in runes. Should be used for strings,
+// slices, arrays, maps or channels.
+func MaxLen(x interface{}, n int) ValidateFunc {
+	return func() error {
+		l, err := length(x)
+		if err != nil {
+			return err
+		}
+		if l > n {
+			return newError(CodeMaxLen, x, map[string]interface{}{"max": n},
+				"length of `%v` is `%d`, greater than `%d`", x, l, n)
+		}
+
+		return nil
+	}
+}
+
+// LenBetween checks if the length of x is greater than or equal to the lower
+// bound and less than or equal to the upper bound. The length of strings is
+// computed in runes. Should be used for strings, slices, arrays, maps
+// or channels.
+func LenBetween(x interface{}, lower, upper int) ValidateFunc {
+	return func() error {
+		if err := MinLen(x, lower)(); err != nil {
+			return err
+		}
+
+		return MaxLen(x, upper)()
+	}
+}
+
+func length(x interface{}) (int, error) {
+	if x == nil {
+		return 0, nil
+	}
+	v := reflect.ValueOf(x)
+
+	kind := v.Kind()
+	switch kind {
+	case reflect.String:
+		return utf8.RuneCountInString(v.String()), nil
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice:
+		return v.Len(), nil
+	}
+
+	return 0, newError(CodeInvalid, x, nil, "cannot compute length of `%v`", kind)
+}
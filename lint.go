@@ -0,0 +1,247 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Warning describes a misconfiguration detected by Lint, such as
+// contradictory or redundant rules.
+type Warning struct {
+	// Field is the path of the struct field the rules are declared for.
+	// It is only set by LintStruct.
+	Field string `json:"field,omitempty"`
+
+	// Rules contains the names of the rules which cause the warning.
+	Rules []string `json:"rules"`
+
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// String returns the message of the warning, prefixed by the field name,
+// if one is set.
+func (w Warning) String() string {
+	if w.Field == "" {
+		return w.Message
+	}
+
+	return w.Field + ": " + w.Message
+}
+
+// Lint detects contradictory rules, which reject all values, and redundant
+// rules, which have no effect, in the specified rule set. Examples include
+// a minimum length greater than the maximum length, reversed bounds, empty
+// `in` lists or `required` combined with rules which only accept empty
+// values. The rules are typically obtained using ParseTag.
+func Lint(rules []Rule) []Warning {
+	var warnings []Warning
+	warn := func(names []string, format string, args ...interface{}) {
+		warnings = append(warnings, Warning{Rules: names, Message: fmt.Sprintf(format, args...)})
+	}
+
+	byName := map[string]Rule{}
+	for _, rule := range rules {
+		if prev, ok := byName[rule.Name]; ok {
+			if prev.Param == rule.Param {
+				warn([]string{rule.Name}, "rule `%s` is declared more than once", rule.Name)
+			} else {
+				warn([]string{rule.Name}, "rule `%s` is declared with conflicting parameters `%s` and `%s`",
+					rule.Name, prev.Param, rule.Param)
+			}
+			continue
+		}
+		byName[rule.Name] = rule
+
+		if !HasRule(rule.Name) {
+			warn([]string{rule.Name}, "unknown rule `%s`", rule.Name)
+		}
+		switch rule.Name {
+		case "len", "min_len", "max_len":
+			if n, err := strconv.Atoi(rule.Param); err != nil || n < 0 {
+				warn([]string{rule.Name}, "invalid length `%s` for rule `%s`", rule.Param, rule.Name)
+			}
+		case "in", "not_in":
+			if strings.TrimSpace(rule.Param) == "" {
+				warn([]string{rule.Name}, "rule `%s` has an empty list of values", rule.Name)
+			}
+		case "match":
+			if _, err := regexp.Compile(rule.Param); err != nil {
+				warn([]string{rule.Name}, "invalid pattern `%s` for rule `match`", rule.Param)
+			}
+		}
+	}
+
+	// Length rules.
+	length := func(name string) (int, bool) {
+		rule, ok := byName[name]
+		if !ok {
+			return 0, false
+		}
+		n, err := strconv.Atoi(rule.Param)
+		return n, err == nil && n >= 0
+	}
+	minLen, hasMin := length("min_len")
+	maxLen, hasMax := length("max_len")
+	exactLen, hasLen := length("len")
+	if hasMin && hasMax && minLen > maxLen {
+		warn([]string{"min_len", "max_len"}, "minimum length `%d` is greater than maximum length `%d`", minLen, maxLen)
+	}
+	if hasLen && hasMin {
+		if exactLen < minLen {
+			warn([]string{"len", "min_len"}, "length `%d` is less than minimum length `%d`", exactLen, minLen)
+		} else {
+			warn([]string{"len", "min_len"}, "rule `min_len` is redundant with rule `len`")
+		}
+	}
+	if hasLen && hasMax {
+		if exactLen > maxLen {
+			warn([]string{"len", "max_len"}, "length `%d` is greater than maximum length `%d`", exactLen, maxLen)
+		} else {
+			warn([]string{"len", "max_len"}, "rule `max_len` is redundant with rule `len`")
+		}
+	}
+
+	// Rules which only accept empty values.
+	if _, ok := byName["required"]; ok {
+		switch {
+		case hasLen && exactLen == 0:
+			warn([]string{"required", "len"}, "rule `required` rejects all values accepted by `len=0`")
+		case hasMax && maxLen == 0:
+			warn([]string{"required", "max_len"}, "rule `required` rejects all values accepted by `max_len=0`")
+		}
+		if rule, ok := byName["eq"]; ok && rule.Param == "" {
+			warn([]string{"required", "eq"}, "rule `required` rejects all values accepted by `eq=`")
+		}
+	}
+
+	// Comparison rules.
+	lower, hasLower := lintBound(byName, "gte", "gt")
+	upper, hasUpper := lintBound(byName, "lte", "lt")
+	if hasLower && hasUpper {
+		exclusive := lower.Name == "gt" || upper.Name == "lt"
+		if c, ok := lintCompare(lower.Param, upper.Param); ok && (c > 0 || (c == 0 && exclusive)) {
+			warn([]string{lower.Name, upper.Name}, "bounds `%s=%s` and `%s=%s` are reversed, no value satisfies both",
+				lower.Name, lower.Param, upper.Name, upper.Param)
+		}
+	}
+	if eq, ok := byName["eq"]; ok {
+		if ne, ok := byName["ne"]; ok && eq.Param == ne.Param {
+			warn([]string{"eq", "ne"}, "rules `eq` and `ne` have the same parameter `%s`", eq.Param)
+		}
+	}
+
+	// Value list rules.
+	if in, ok := byName["in"]; ok && strings.TrimSpace(in.Param) != "" {
+		if notIn, ok := byName["not_in"]; ok {
+			excluded := map[string]bool{}
+			for _, v := range strings.Split(notIn.Param, "|") {
+				excluded[v] = true
+			}
+
+			allowed := false
+			for _, v := range strings.Split(in.Param, "|") {
+				allowed = allowed || !excluded[v]
+			}
+			if !allowed {
+				warn([]string{"in", "not_in"}, "all values of rule `in` are excluded by rule `not_in`")
+			}
+		}
+	}
+
+	return warnings
+}
+
+// LintStruct lints the rules declared in the `check` struct tags of the
+// fields of v, including the fields of nested structs. The value of v is
+// not validated, only its type is inspected.
+func LintStruct(v interface{}) []Warning {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+
+	return lintStruct(t, "", map[reflect.Type]bool{})
+}
+
+func lintStruct(t reflect.Type, prefix string, visited map[reflect.Type]bool) []Warning {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType || visited[t] {
+		return nil
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	var warnings []Warning
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		tag, ok := sf.Tag.Lookup("check")
+		if tag == "-" {
+			continue
+		}
+
+		path := prefix
+		if !sf.Anonymous {
+			path = joinPath(prefix, sf.Name)
+		}
+
+		if ok {
+			rules, err := ParseTag(tag)
+			if err != nil {
+				warnings = append(warnings, Warning{Field: path, Message: toError(err).Message})
+			}
+			for _, w := range Lint(rules) {
+				w.Field = path
+				warnings = append(warnings, w)
+			}
+		}
+		warnings = append(warnings, lintStruct(sf.Type, path, visited)...)
+	}
+
+	return warnings
+}
+
+// lintBound returns the first of the specified rules which is declared.
+func lintBound(rules map[string]Rule, names ...string) (Rule, bool) {
+	for _, name := range names {
+		if rule, ok := rules[name]; ok {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// lintCompare compares the a and b rule parameters as numbers, times or
+// durations. The returned boolean is false if the parameters cannot be
+// compared.
+func lintCompare(a, b string) (int, bool) {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return cmp.Compare(x, y), true
+		}
+	}
+	if x, err := time.Parse(time.RFC3339, a); err == nil {
+		if y, err := time.Parse(time.RFC3339, b); err == nil {
+			return x.Compare(y), true
+		}
+	}
+	if x, err := time.ParseDuration(a); err == nil {
+		if y, err := time.ParseDuration(b); err == nil {
+			return cmp.Compare(x, y), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package check
+
+import (
+	"image"
+	_ "image/gif"  // Register the GIF decoder used by ImageDimensions.
+	_ "image/jpeg" // Register the JPEG decoder used by ImageDimensions.
+	_ "image/png"  // Register the PNG decoder used by ImageDimensions.
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// MIMEType checks if the media type of data, detected using the algorithm
+// of http.DetectContentType, is one of the allowed types. The allowed types
+// can contain wildcard subtypes (e.g. `image/*`). Media type parameters,
+// such as the charset, are ignored. If no types are specified, any type
+// other than the generic `application/octet-stream` is allowed.
+func MIMEType(data []byte, allowed ...string) ValidateFunc {
+	return func() error {
+		detected := http.DetectContentType(data)
+		mediaType, _, err := mime.ParseMediaType(detected)
+		if err != nil {
+			mediaType = detected
+		}
+
+		if len(allowed) == 0 {
+			if mediaType != "application/octet-stream" {
+				return nil
+			}
+		}
+		for _, a := range allowed {
+			if matchMediaType(mediaType, a) {
+				return nil
+			}
+		}
+
+		return newError(CodeMIMEType, mediaType, map[string]interface{}{"allowed": allowed},
+			"media type `%s` is not allowed", mediaType)
+	}
+}
+
+// matchMediaType reports whether the media type matches the pattern, which
+// can contain a wildcard subtype (e.g. `image/*`).
+func matchMediaType(mediaType, pattern string) bool {
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if mt, _, err := mime.ParseMediaType(pattern); err == nil {
+		pattern = mt
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(mediaType, prefix+"/")
+	}
+
+	return mediaType == pattern || pattern == "*/*"
+}
+
+// ImageDimensions checks if r contains a GIF, JPEG or PNG image with a width
+// of at most maxW pixels and a height of at most maxH pixels. Only the
+// header of the image is decoded. Additional image formats can be supported
+// by registering their decoders with the image package.
+func ImageDimensions(r io.Reader, maxW, maxH int) ValidateFunc {
+	return func() error {
+		cfg, format, err := image.DecodeConfig(r)
+		if err != nil {
+			e := newError(CodeImageDimensions, nil, nil, "invalid image: %s", err)
+			e.Err = err
+			return e
+		}
+		if cfg.Width > maxW || cfg.Height > maxH {
+			return newError(CodeImageDimensions, nil, map[string]interface{}{
+				"width":      cfg.Width,
+				"height":     cfg.Height,
+				"max_width":  maxW,
+				"max_height": maxH,
+				"format":     format,
+			}, "image dimensions `%dx%d` exceed `%dx%d`", cfg.Width, cfg.Height, maxW, maxH)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,202 @@
+package check
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// Positive checks if the number x is greater than zero.
+func Positive(x interface{}) ValidateFunc {
+	return func() error {
+		sign, err := numberSign(x)
+		if err != nil {
+			return err
+		}
+		if sign <= 0 {
+			return newError(CodePositive, x, nil, "`%v` must be positive", x)
+		}
+
+		return nil
+	}
+}
+
+// Negative checks if the number x is less than zero.
+func Negative(x interface{}) ValidateFunc {
+	return func() error {
+		sign, err := numberSign(x)
+		if err != nil {
+			return err
+		}
+		if sign >= 0 {
+			return newError(CodeNegative, x, nil, "`%v` must be negative", x)
+		}
+
+		return nil
+	}
+}
+
+// NonNegative checks if the number x is greater than or equal to zero.
+func NonNegative(x interface{}) ValidateFunc {
+	return func() error {
+		sign, err := numberSign(x)
+		if err != nil {
+			return err
+		}
+		if sign < 0 {
+			return newError(CodeNonNegative, x, nil, "`%v` cannot be negative", x)
+		}
+
+		return nil
+	}
+}
+
+// MultipleOf checks if the number x is a multiple of n. Integers of any
+// signedness and size can be mixed. Floats are compared using their shortest
+// decimal representation, so that 0.3 is a multiple of 0.1, while the results
+// of inexact float operations (e.g. 0.1 + 0.2) might not be multiples.
+// Returns an error if n is zero.
+func MultipleOf(x, n interface{}) ValidateFunc {
+	return func() error {
+		ok, err := isMultiple(x, n)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return newError(CodeMultipleOf, x, map[string]interface{}{"n": n},
+				"`%v` is not a multiple of `%v`", x, n)
+		}
+
+		return nil
+	}
+}
+
+// DivisibleBy checks if the number x is divisible by n. It performs the
+// same check as MultipleOf, but reports failures using a different message.
+func DivisibleBy(x, n interface{}) ValidateFunc {
+	return func() error {
+		ok, err := isMultiple(x, n)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return newError(CodeMultipleOf, x, map[string]interface{}{"n": n},
+				"`%v` is not divisible by `%v`", x, n)
+		}
+
+		return nil
+	}
+}
+
+func numberSign(x interface{}) (int, error) {
+	if x == nil {
+		return 0, newError(CodeInvalid, nil, nil, "cannot convert nil to a number")
+	}
+
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch n := v.Int(); {
+		case n < 0:
+			return -1, nil
+		case n > 0:
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > 0 {
+			return 1, nil
+		}
+		return 0, nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsNaN(f):
+			return 0, newError(CodeInvalid, x, nil, "`%v` is not a number", x)
+		case f < 0:
+			return -1, nil
+		case f > 0:
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	return 0, newError(CodeInvalid, x, nil, "cannot convert `%v` to a number", v.Kind())
+}
+
+func isMultiple(x, n interface{}) (bool, error) {
+	xi, xok, err := numberInt(x)
+	if err != nil {
+		return false, err
+	}
+	ni, nok, err := numberInt(n)
+	if err != nil {
+		return false, err
+	}
+
+	if xok && nok {
+		if ni.Sign() == 0 {
+			return false, newError(CodeInvalid, x, nil, "cannot check multiples of zero")
+		}
+		return new(big.Int).Rem(xi, ni).Sign() == 0, nil
+	}
+
+	xf, nf := numberFloat(x), numberFloat(n)
+	if nf == 0 || math.IsNaN(nf) || math.IsInf(nf, 0) {
+		return false, newError(CodeInvalid, x, nil, "cannot check multiples of `%v`", n)
+	}
+	if math.IsNaN(xf) || math.IsInf(xf, 0) {
+		return false, nil
+	}
+
+	// Compare the decimal representations of the numbers, which are exact,
+	// unlike their binary representations (e.g. 0.3 is a multiple of 0.1).
+	q := new(big.Rat).Quo(numberRat(x, xi), numberRat(n, ni))
+	return q.IsInt(), nil
+}
+
+// numberRat returns the value of the number x as a rational number. Floats
+// are converted using their shortest decimal representation. The integer
+// value of x is used if it is not nil.
+func numberRat(x interface{}, xi *big.Int) *big.Rat {
+	if xi != nil {
+		return new(big.Rat).SetInt(xi)
+	}
+
+	bitSize := 64
+	if isFloat32(x) {
+		bitSize = 32
+	}
+
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(numberFloat(x), 'g', -1, bitSize))
+	return r
+}
+
+// numberInt returns the value of the integer x. The returned flag is false
+// if x is a float.
+func numberInt(x interface{}) (*big.Int, bool, error) {
+	if x == nil {
+		return nil, false, newError(CodeInvalid, nil, nil, "cannot convert nil to a number")
+	}
+
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), true, nil
+	case reflect.Float32, reflect.Float64:
+		return nil, false, nil
+	}
+
+	return nil, false, newError(CodeInvalid, x, nil, "cannot convert `%v` to a number", v.Kind())
+}
+
+func numberFloat(x interface{}) float64 {
+	return numberValue(reflect.ValueOf(x))
+}
+
+func isFloat32(x interface{}) bool {
+	return reflect.ValueOf(x).Kind() == reflect.Float32
+}
@@ -0,0 +1,110 @@
+package check
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Observer is notified of the execution of validation functions, in order
+// to emit metrics or traces (e.g. failure counters by field and error code).
+// The observers are notified of the functions executed by Run, RunCtx,
+// RunCtxBudget, RunParallel, Report, RunWarnings, RunInstrumented,
+// Runner.Run, Batch and Partition, and of the ones used by Validator and
+// Builder. Nested functions, such as the ones passed to Field or And, are
+// not observed individually. The error code and field of the failures can
+// be obtained from the *Error returned by the functions.
+type Observer interface {
+	// ObserveCheck is called after the execution of a validation function,
+	// with the name of the validator which created it (e.g. `check.Email`,
+	// see TraceEntry), the returned error and the execution time.
+	ObserveCheck(name string, err error, d time.Duration)
+}
+
+// ObserverFunc is an adapter which allows the use of ordinary functions
+// as observers.
+type ObserverFunc func(name string, err error, d time.Duration)
+
+// ObserveCheck calls f(name, err, d).
+func (f ObserverFunc) ObserveCheck(name string, err error, d time.Duration) {
+	f(name, err, d)
+}
+
+type observerEntry struct {
+	observer Observer
+}
+
+var (
+	observers   atomic.Pointer[[]*observerEntry]
+	observersMu sync.Mutex
+)
+
+// AddObserver registers an observer, which is notified of the execution of
+// validation functions, and returns a function which unregisters it. The
+// observers must be safe for concurrent use.
+func AddObserver(o Observer) (remove func()) {
+	entry := &observerEntry{observer: o}
+
+	observersMu.Lock()
+	defer observersMu.Unlock()
+
+	var entries []*observerEntry
+	if current := observers.Load(); current != nil {
+		entries = append(entries, *current...)
+	}
+	entries = append(entries, entry)
+	observers.Store(&entries)
+
+	return func() {
+		observersMu.Lock()
+		defer observersMu.Unlock()
+
+		var entries []*observerEntry
+		for _, e := range *observers.Load() {
+			if e != entry {
+				entries = append(entries, e)
+			}
+		}
+		observers.Store(&entries)
+	}
+}
+
+// OnCheck registers fn as an observer (see AddObserver) and returns
+// a function which unregisters it.
+//
+//	check.OnCheck(func(name string, err error, d time.Duration) {
+//		checkDuration.WithLabelValues(name).Observe(d.Seconds())
+//	})
+func OnCheck(fn func(name string, err error, d time.Duration)) (remove func()) {
+	return AddObserver(ObserverFunc(fn))
+}
+
+func loadObservers() []*observerEntry {
+	if entries := observers.Load(); entries != nil {
+		return *entries
+	}
+
+	return nil
+}
+
+// runCheck executes the wrapped version of the validation function vf and
+// notifies the observers, if any. The observers receive the name of vf,
+// which must be a ValidateFunc or a ValidateCtxFunc.
+func runCheck(vf interface{}, wrapped ValidateFunc) error {
+	entries := loadObservers()
+	if len(entries) == 0 {
+		return wrapped()
+	}
+
+	start := time.Now()
+	err := wrapped()
+	notifyObservers(entries, funcName(vf), err, time.Since(start))
+
+	return err
+}
+
+func notifyObservers(entries []*observerEntry, name string, err error, d time.Duration) {
+	for _, entry := range entries {
+		entry.observer.ObserveCheck(name, err, d)
+	}
+}
@@ -0,0 +1,52 @@
+package check
+
+import (
+	"runtime"
+	"sync"
+)
+
+// RunParallel executes a list of validation functions concurrently, using at
+// most concurrency goroutines. If concurrency is not a positive number, the
+// number of logical CPUs is used instead. All the validation functions are
+// executed and the errors are returned as Errors, in the order of the
+// validation functions that produced them.
+func RunParallel(concurrency int, vfs ...ValidateFunc) error {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+	if concurrency > len(vfs) {
+		concurrency = len(vfs)
+	}
+
+	results := make([]error, len(vfs))
+	idxs := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range idxs {
+				results[idx] = runCheck(vfs[idx], vfs[idx])
+			}
+		}()
+	}
+
+	for i := range vfs {
+		idxs <- i
+	}
+	close(idxs)
+	wg.Wait()
+
+	var errs Errors
+	for _, err := range results {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
@@ -1,32 +1,6 @@
 package check
 
-import "regexp"
-
 const (
-	patternURL = "^" +
-		// protocol identifier
-		"(?:(?:https?|ftp)://)?" +
-		// user:pass authentication
-		`(?:\S+(?::\S*)?@)?` +
-		"(?:" +
-		// IP address dotted notation octets
-		`(?:[1-9]\d?|1\d\d|2[01]\d|22[0-3])` +
-		`(?:\.(?:1?\d{1,2}|2[0-4]\d|25[0-5])){2}` +
-		`(?:\.(?:[1-9]\d?|1\d\d|2[0-4]\d|25[0-4]))` +
-		"|" +
-		// host name
-		"(?:(?:[a-z\u00a1-\uffff0-9]-*)*[a-z\u00a1-\uffff0-9]+)" +
-		// domain name
-		"(?:\\.(?:[a-z\u00a1-\uffff0-9]-*)*[a-z\u00a1-\uffff0-9]+)*" +
-		// TLD identifier
-		"(?:\\.(?:[a-z\u00a1-\uffff]{2,}))" +
-		")" +
-		// port number
-		`(?::\d{2,5})?` +
-		// resource path
-		`(?:/\S*)?` +
-		"$"
-
 	patternVAT = "^(" +
 		"(AT)?U[0-9]{8}|" +
 		"(BE)?0[0-9]{9}|" +
@@ -57,17 +31,47 @@ const (
 		"(SK)?[0-9]{10}" +
 		")$"
 
-	patternIBAN = "^" +
-		"[a-zA-Z]{2}" +
-		"[0-9]{2}" +
-		"[a-zA-Z0-9]{4}" +
-		"[0-9]{7}" +
-		"([a-zA-Z0-9]?){0,16}" +
+	patternBIC = "^" +
+		// institution code
+		"[A-Z]{4}" +
+		// country code
+		"([A-Z]{2})" +
+		// location code
+		"[A-Z0-9]{2}" +
+		// branch code
+		"(?:[A-Z0-9]{3})?" +
+		"$"
+
+	patternUUID = "^" +
+		"[0-9a-fA-F]{8}-" +
+		"[0-9a-fA-F]{4}-" +
+		"[0-9a-fA-F]{4}-" +
+		"[0-9a-fA-F]{4}-" +
+		"[0-9a-fA-F]{12}" +
 		"$"
+
+	// Crockford's base32 alphabet. The first character is limited to 0-7
+	// because a ULID encodes 128 bits in 26 characters.
+	patternULID = "^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$"
+
+	// Base62 encoding of 20 bytes: a 4 byte timestamp and a 16 byte
+	// random payload.
+	patternKSUID = "^[0-9A-Za-z]{27}$"
+
+	patternRequestID = `^[A-Za-z0-9._:\-]{1,128}$`
+
+	patternE164 = `^\+[1-9]\d{1,14}$`
 )
 
+// The built-in patterns are compiled on first use, in order to avoid paying
+// the compilation cost on start-up for patterns which are never used.
 var (
-	regURL  = regexp.MustCompile(patternURL)
-	regVAT  = regexp.MustCompile(patternVAT)
-	regIBAN = regexp.MustCompile(patternIBAN)
+	regVAT   = newLazyRegexp(patternVAT)
+	regBIC   = newLazyRegexp(patternBIC)
+	regUUID  = newLazyRegexp(patternUUID)
+	regULID  = newLazyRegexp(patternULID)
+	regKSUID = newLazyRegexp(patternKSUID)
+
+	regRequestID = newLazyRegexp(patternRequestID)
+	regE164      = newLazyRegexp(patternE164)
 )
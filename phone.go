@@ -0,0 +1,124 @@
+package check
+
+import "strings"
+
+type phoneRegion struct {
+	code     string
+	trunk    string
+	minLen   int
+	maxLen   int
+	optTrunk bool
+}
+
+// Calling codes, national trunk prefixes and lengths of the national
+// significant numbers of the supported regions. Optional trunk prefixes
+// are stripped only if the number would be too long otherwise.
+var phoneRegions = map[string]phoneRegion{
+	"AT": {"43", "0", 4, 13, false},
+	"AU": {"61", "0", 9, 9, false},
+	"BE": {"32", "0", 8, 9, false},
+	"BR": {"55", "0", 10, 11, false},
+	"CA": {"1", "1", 10, 10, true},
+	"CH": {"41", "0", 9, 9, false},
+	"CN": {"86", "0", 10, 11, false},
+	"DE": {"49", "0", 6, 13, false},
+	"DK": {"45", "", 8, 8, false},
+	"ES": {"34", "", 9, 9, false},
+	"FI": {"358", "0", 5, 12, false},
+	"FR": {"33", "0", 9, 9, false},
+	"GB": {"44", "0", 9, 10, false},
+	"IE": {"353", "0", 7, 9, false},
+	"IN": {"91", "0", 10, 10, false},
+	"IT": {"39", "", 6, 11, false},
+	"JP": {"81", "0", 9, 10, false},
+	"MX": {"52", "", 10, 10, false},
+	"NL": {"31", "0", 9, 9, false},
+	"NO": {"47", "", 8, 8, false},
+	"NZ": {"64", "0", 8, 10, false},
+	"PL": {"48", "", 9, 9, false},
+	"PT": {"351", "", 9, 9, false},
+	"RO": {"40", "0", 9, 9, false},
+	"RU": {"7", "8", 10, 10, false},
+	"SE": {"46", "0", 7, 9, false},
+	"US": {"1", "1", 10, 10, true},
+	"ZA": {"27", "0", 9, 9, false},
+}
+
+// Phone checks if the number parameter is a valid phone number in the E.164
+// format (e.g. `+442079460958`). Spaces, dashes, dots and parentheses are
+// ignored. The number can be empty if the required parameter is false.
+func Phone(number string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(number) {
+			return requiredErr(required, "phone number cannot be empty")
+		}
+		if ok := regE164.MatchString(normalizePhone(number)); !ok {
+			return newError(CodePhone, number, nil, "invalid phone number `%s`", number)
+		}
+
+		return nil
+	}
+}
+
+// PhoneRegion checks if the number parameter is a valid phone number of the
+// specified region (ISO 3166-1 alpha-2 country code). The number can be in
+// the E.164 format, in which case the calling code must match the region, or
+// in the national format of the region. The length of the national number is
+// checked using the rules of the region. Spaces, dashes, dots and parentheses
+// are ignored. The number can be empty if the required parameter is false.
+func PhoneRegion(number, region string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(number) {
+			return requiredErr(required, "phone number cannot be empty")
+		}
+
+		rules, ok := phoneRegions[strings.ToUpper(region)]
+		if !ok {
+			return newError(CodeInvalid, number, map[string]interface{}{"region": region},
+				"unsupported phone number region `%s`", region)
+		}
+
+		national := normalizePhone(number)
+		if strings.HasPrefix(national, "+") {
+			if !regE164.MatchString(national) || !strings.HasPrefix(national[1:], rules.code) {
+				return phoneRegionError(number, region)
+			}
+			national = national[1+len(rules.code):]
+		} else if rules.trunk != "" {
+			if strings.HasPrefix(national, rules.trunk) {
+				if !rules.optTrunk || len(national) > rules.maxLen {
+					national = national[len(rules.trunk):]
+				}
+			} else if !rules.optTrunk {
+				return phoneRegionError(number, region)
+			}
+		}
+
+		if len(national) < rules.minLen || len(national) > rules.maxLen {
+			return phoneRegionError(number, region)
+		}
+		for _, r := range national {
+			if r < '0' || r > '9' {
+				return phoneRegionError(number, region)
+			}
+		}
+
+		return nil
+	}
+}
+
+func phoneRegionError(number, region string) error {
+	return newError(CodePhone, number, map[string]interface{}{"region": region},
+		"invalid phone number `%s` for region `%s`", number, region)
+}
+
+func normalizePhone(number string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+
+		return r
+	}, strings.TrimSpace(number))
+}
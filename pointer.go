@@ -0,0 +1,106 @@
+package check
+
+import "reflect"
+
+// NotNil checks if ptr is not nil. Unlike Required, it only checks the
+// reference itself, so non-nil pointers to zero values are valid. Nil
+// pointers, interfaces, maps, slices, channels and functions are invalid.
+func NotNil(ptr interface{}) ValidateFunc {
+	return func() error {
+		_, err := deref(ptr)
+		return err
+	}
+}
+
+// PtrEq checks if the value referenced by ptr is equal to the comparison
+// term (see Eq). Fails with the CodeNil code if ptr is nil.
+func PtrEq(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Eq, ptr, term)
+}
+
+// PtrNe checks if the value referenced by ptr is not equal to the
+// comparison term (see Ne). Fails with the CodeNil code if ptr is nil.
+func PtrNe(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Ne, ptr, term)
+}
+
+// PtrLt checks if the value referenced by ptr is less than the comparison
+// term (see Lt). Fails with the CodeNil code if ptr is nil.
+func PtrLt(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Lt, ptr, term)
+}
+
+// PtrLte checks if the value referenced by ptr is less than or equal to the
+// comparison term (see Lte). Fails with the CodeNil code if ptr is nil.
+func PtrLte(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Lte, ptr, term)
+}
+
+// PtrGt checks if the value referenced by ptr is greater than the
+// comparison term (see Gt). Fails with the CodeNil code if ptr is nil.
+func PtrGt(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Gt, ptr, term)
+}
+
+// PtrGte checks if the value referenced by ptr is greater than or equal to
+// the comparison term (see Gte). Fails with the CodeNil code if ptr is nil.
+func PtrGte(ptr, term interface{}) ValidateFunc {
+	return ptrCmp(Gte, ptr, term)
+}
+
+// PtrBetween checks if the value referenced by ptr is between the lower and
+// upper bounds, inclusive (see Between). Fails with the CodeNil code if ptr
+// is nil.
+func PtrBetween(ptr, lower, upper interface{}) ValidateFunc {
+	return func() error {
+		x, err := deref(ptr)
+		if err != nil {
+			return err
+		}
+
+		return Between(x, lower, upper)()
+	}
+}
+
+func ptrCmp(fn func(x, term interface{}) ValidateFunc, ptr, term interface{}) ValidateFunc {
+	return func() error {
+		x, err := deref(ptr)
+		if err != nil {
+			return err
+		}
+
+		return fn(x, term)()
+	}
+}
+
+// deref returns the value referenced by ptr, following all the levels of
+// indirection. Returns an error if any of the references is nil.
+func deref(ptr interface{}) (interface{}, error) {
+	if ptr == nil {
+		return nil, newError(CodeNil, nil, nil, "value cannot be nil")
+	}
+
+	v := reflect.ValueOf(ptr)
+	for {
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if v.IsNil() {
+				return nil, nilError(ptr)
+			}
+			v = v.Elem()
+			continue
+		case reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			if v.IsNil() {
+				return nil, nilError(ptr)
+			}
+		}
+
+		return v.Interface(), nil
+	}
+}
+
+func nilError(ptr interface{}) error {
+	t := reflect.TypeOf(ptr).String()
+	return newError(CodeNil, nil, map[string]interface{}{"type": t},
+		"value of type `%s` cannot be nil", t)
+}
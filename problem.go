@@ -0,0 +1,89 @@
+package check
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of problem details documents.
+const ProblemContentType = "application/problem+json"
+
+// Violation describes a failed check of a problem details document.
+type Violation struct {
+	Field  string                 `json:"field,omitempty"`
+	Code   string                 `json:"code"`
+	Detail string                 `json:"detail"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// Problem represents an RFC 9457 problem details document. The failed checks
+// are listed in the errors extension member.
+type Problem struct {
+	Type     string      `json:"type,omitempty"`
+	Title    string      `json:"title,omitempty"`
+	Status   int         `json:"status,omitempty"`
+	Detail   string      `json:"detail,omitempty"`
+	Instance string      `json:"instance,omitempty"`
+	Errors   []Violation `json:"errors,omitempty"`
+}
+
+// NewProblem converts the error returned by a validation run to a problem
+// details document with the 422 (Unprocessable Content) status. Errors of
+// type Errors produce a violation for each of the contained errors. The
+// details of the document and of the violations are the messages of the
+// errors, which usually contain the validated values, as do some of the
+// parameters of the checks (e.g. the `diff` parameter of Eq). Use
+// WithMessage or a translator to keep sensitive values out of the document.
+func NewProblem(err error) *Problem {
+	p := &Problem{
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+	}
+	if err == nil {
+		return p
+	}
+
+	p.Detail = err.Error()
+	for _, err := range flattenErrors(err) {
+		e := toError(err)
+		p.Errors = append(p.Errors, Violation{
+			Field:  e.Field,
+			Code:   e.Code,
+			Detail: e.Message,
+			Params: encodableParams(e.Params),
+		})
+	}
+
+	return p
+}
+
+// WriteProblem writes the problem details document of err to w, using the
+// problem details media type and the status of the document. Parameters
+// which cannot be encoded as JSON are left out of the document.
+func WriteProblem(w http.ResponseWriter, err error) error {
+	p := NewProblem(err)
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(data)
+	return err
+}
+
+func flattenErrors(err error) []error {
+	errs, ok := err.(Errors)
+	if !ok {
+		return []error{err}
+	}
+
+	var flat []error
+	for _, err := range errs {
+		flat = append(flat, flattenErrors(err)...)
+	}
+
+	return flat
+}
@@ -0,0 +1,154 @@
+package check
+
+import (
+	"math"
+	"reflect"
+)
+
+// WithinQuota checks if the requested amount fits in the remaining quota,
+// computed as the difference between limit and the amount already used.
+// The arguments must be of the same numeric kind (signed integer, unsigned
+// integer or floating point). The returned error states the requested and
+// remaining amounts, along with the limit.
+func WithinQuota(requested, used, limit interface{}) ValidateFunc {
+	return func() error {
+		kind := reflect.ValueOf(requested).Kind()
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			r, u, l, err := toInt64s(requested, used, limit)
+			if err != nil {
+				return err
+			}
+			if remaining := subInt64(l, u); r > remaining {
+				return quotaError(r, remaining, l)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			r, u, l, err := toUint64s(requested, used, limit)
+			if err != nil {
+				return err
+			}
+
+			var remaining uint64
+			if u < l {
+				remaining = l - u
+			}
+			if r > remaining {
+				return quotaError(r, remaining, l)
+			}
+		case reflect.Float32, reflect.Float64:
+			r, u, l, err := toFloat64s(requested, used, limit)
+			if err != nil {
+				return err
+			}
+			if remaining := l - u; r > remaining {
+				return quotaError(r, remaining, l)
+			}
+		default:
+			return newError(CodeInvalid, requested, nil, "cannot compute quota for `%v`", kind)
+		}
+
+		return nil
+	}
+}
+
+// Budget checks if the sum of the items does not exceed the specified limit.
+// The returned error states the requested total, the amount by which it
+// exceeds the limit and the remaining budget, along with the limit. The
+// remaining budget is the difference between the limit and the sum of the
+// items preceding the first one which exceeds the limit, clamped at 0.
+func Budget(items []float64, limit float64) ValidateFunc {
+	return func() error {
+		var total, used float64
+		exceeded := false
+		for _, item := range items {
+			if total += item; !exceeded && total > limit {
+				used, exceeded = total-item, true
+			}
+		}
+		if total > limit {
+			excess := total - limit
+			remaining := math.Max(limit-used, 0)
+			return newError(CodeBudget, items, map[string]interface{}{
+				"requested": total,
+				"remaining": remaining,
+				"excess":    excess,
+				"limit":     limit,
+			}, "requested total `%v` exceeds budget `%v` by `%v` (remaining `%v`)",
+				total, limit, excess, remaining)
+		}
+
+		return nil
+	}
+}
+
+func quotaError(requested, remaining, limit interface{}) error {
+	return newError(CodeQuota, requested, map[string]interface{}{
+		"requested": requested,
+		"remaining": remaining,
+		"limit":     limit,
+	}, "requested `%v` exceeds remaining quota `%v` (limit `%v`)", requested, remaining, limit)
+}
+
+// subInt64 returns the difference between x and y, saturated at the bounds
+// of int64 instead of overflowing.
+func subInt64(x, y int64) int64 {
+	switch {
+	case y < 0 && x > math.MaxInt64+y:
+		return math.MaxInt64
+	case y > 0 && x < math.MinInt64+y:
+		return math.MinInt64
+	}
+
+	return x - y
+}
+
+func toInt64s(r, u, l interface{}) (int64, int64, int64, error) {
+	x, err := toInt64(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	y, err := toInt64(u)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	z, err := toInt64(l)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return x, y, z, nil
+}
+
+func toUint64s(r, u, l interface{}) (uint64, uint64, uint64, error) {
+	x, err := toUint64(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	y, err := toUint64(u)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	z, err := toUint64(l)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return x, y, z, nil
+}
+
+func toFloat64s(r, u, l interface{}) (float64, float64, float64, error) {
+	x, err := toFloat64(r)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	y, err := toFloat64(u)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	z, err := toFloat64(l)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return x, y, z, nil
+}
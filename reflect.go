@@ -1,8 +1,7 @@
 package check
 
 import (
-	"errors"
-	"fmt"
+	"database/sql/driver"
 	"reflect"
 	"time"
 )
@@ -21,6 +20,9 @@ func isEmpty(x interface{}) bool {
 	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
 		return v.Len() == 0
 	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
 		return isEmpty(v.Elem().Interface())
 	}
 
@@ -29,7 +31,7 @@ func isEmpty(x interface{}) bool {
 
 func toInt64(x interface{}) (int64, error) {
 	if x == nil {
-		return 0, errors.New("cannot convert nil to type int64")
+		return 0, newError(CodeInvalid, nil, nil, "cannot convert nil to type int64")
 	}
 	v := reflect.ValueOf(x)
 
@@ -39,12 +41,12 @@ func toInt64(x interface{}) (int64, error) {
 		return v.Int(), nil
 	}
 
-	return 0, fmt.Errorf("cannot convert `%v` to type int64", kind)
+	return 0, newError(CodeInvalid, x, nil, "cannot convert `%v` to type int64", kind)
 }
 
 func toUint64(x interface{}) (uint64, error) {
 	if x == nil {
-		return 0, errors.New("cannot convert nil to type uint64")
+		return 0, newError(CodeInvalid, nil, nil, "cannot convert nil to type uint64")
 	}
 	v := reflect.ValueOf(x)
 
@@ -54,12 +56,12 @@ func toUint64(x interface{}) (uint64, error) {
 		return v.Uint(), nil
 	}
 
-	return 0, fmt.Errorf("cannot convert `%v` to type uint64", kind)
+	return 0, newError(CodeInvalid, x, nil, "cannot convert `%v` to type uint64", kind)
 }
 
 func toFloat64(x interface{}) (float64, error) {
 	if x == nil {
-		return 0, errors.New("cannot convert nil to type float64")
+		return 0, newError(CodeInvalid, nil, nil, "cannot convert nil to type float64")
 	}
 	v := reflect.ValueOf(x)
 
@@ -69,12 +71,12 @@ func toFloat64(x interface{}) (float64, error) {
 		return v.Float(), nil
 	}
 
-	return 0, fmt.Errorf("cannot convert `%v` to type float64", kind)
+	return 0, newError(CodeInvalid, x, nil, "cannot convert `%v` to type float64", kind)
 }
 
 func toString(x interface{}) (string, error) {
 	if x == nil {
-		return "", errors.New("cannot convert nil to type string")
+		return "", newError(CodeInvalid, nil, nil, "cannot convert nil to type string")
 	}
 	v := reflect.ValueOf(x)
 
@@ -83,18 +85,41 @@ func toString(x interface{}) (string, error) {
 		return v.String(), nil
 	}
 
-	return "", fmt.Errorf("cannot convert `%v` to type string", kind)
+	return "", newError(CodeInvalid, x, nil, "cannot convert `%v` to type string", kind)
 }
 
 func toTime(x interface{}) (time.Time, error) {
 	if x == nil {
-		return time.Time{}, errors.New("cannot convert nil to type time.Time")
+		return time.Time{}, newError(CodeInvalid, nil, nil, "cannot convert nil to type time.Time")
 	}
 
 	v, ok := x.(time.Time)
 	if !ok {
-		return time.Time{}, fmt.Errorf("cannot convert `%v` to time.Time", reflect.TypeOf(x))
+		return time.Time{}, newError(CodeInvalid, x, nil, "cannot convert `%v` to time.Time", reflect.TypeOf(x))
 	}
 
 	return v, nil
 }
+
+// valuerValue returns the value of x, if it implements driver.Valuer (e.g.
+// sql.NullString), or x otherwise. Byte slices are converted to strings.
+// The returned flag is true if the value is NULL.
+func valuerValue(x interface{}) (interface{}, bool, error) {
+	valuer, ok := x.(driver.Valuer)
+	if !ok {
+		return x, false, nil
+	}
+
+	v, err := valuer.Value()
+	if err != nil {
+		return nil, false, newError(CodeInvalid, x, nil, "cannot get the value of `%T`: %s", x, err)
+	}
+	switch v := v.(type) {
+	case nil:
+		return nil, true, nil
+	case []byte:
+		return string(v), false, nil
+	}
+
+	return v, false, nil
+}
@@ -0,0 +1,199 @@
+package check
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// maxCachedPatterns is the maximum number of compiled patterns retained by
+// the pattern cache. When the limit is reached, the least recently used
+// pattern is evicted.
+const maxCachedPatterns = 256
+
+// lazyRegexp is a regular expression which is compiled on first use.
+type lazyRegexp struct {
+	expr string
+	once sync.Once
+	re   *regexp.Regexp
+}
+
+func newLazyRegexp(expr string) *lazyRegexp {
+	return &lazyRegexp{expr: expr}
+}
+
+func (r *lazyRegexp) get() *regexp.Regexp {
+	r.once.Do(func() {
+		r.re = regexp.MustCompile(r.expr)
+	})
+
+	return r.re
+}
+
+func (r *lazyRegexp) MatchString(s string) bool {
+	return r.get().MatchString(s)
+}
+
+func (r *lazyRegexp) FindStringSubmatch(s string) []string {
+	return r.get().FindStringSubmatch(s)
+}
+
+// patternLRU is a size-bounded cache of compiled patterns, indexed by
+// their expression.
+type patternLRU struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type patternEntry struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+func newPatternLRU(max int) *patternLRU {
+	return &patternLRU{
+		max:     max,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (c *patternLRU) get(expr string) (*regexp.Regexp, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[expr]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+
+	return elem.Value.(*patternEntry).re, true
+}
+
+func (c *patternLRU) add(expr string, re *regexp.Regexp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[expr]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[expr] = c.order.PushFront(&patternEntry{expr: expr, re: re})
+
+	for c.order.Len() > c.max {
+		elem := c.order.Back()
+		c.order.Remove(elem)
+		delete(c.entries, elem.Value.(*patternEntry).expr)
+	}
+}
+
+// patternCache contains the compiled patterns used by Matches.
+var patternCache = newPatternLRU(maxCachedPatterns)
+
+// compilePattern returns the compiled form of the specified regular
+// expression. Compiled patterns are cached, so that frequently used
+// expressions are only compiled once.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patternCache.get(pattern); ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patternCache.add(pattern, re)
+
+	return re, nil
+}
+
+var (
+	namedPatternsMu sync.RWMutex
+	namedPatterns   = map[string]*lazyRegexp{
+		"bic":        regBIC,
+		"e164":       regE164,
+		"ksuid":      regKSUID,
+		"request_id": regRequestID,
+		"ulid":       regULID,
+		"uuid":       regUUID,
+		"vat":        regVAT,
+	}
+)
+
+// RegisterPattern registers the regular expression expr under the specified
+// name, so that it can be used by MatchesNamed and by the `pattern` rule.
+// Registering a pattern with the name of an existing pattern replaces it.
+// Returns an error if the expression cannot be compiled.
+func RegisterPattern(name, expr string) error {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return newError(CodeInvalid, expr, map[string]interface{}{"pattern": expr},
+			"invalid pattern `%s`", expr)
+	}
+
+	lr := newLazyRegexp(expr)
+	lr.once.Do(func() { lr.re = re })
+
+	namedPatternsMu.Lock()
+	namedPatterns[name] = lr
+	namedPatternsMu.Unlock()
+
+	return nil
+}
+
+func lookupPattern(name string) (*lazyRegexp, bool) {
+	namedPatternsMu.RLock()
+	defer namedPatternsMu.RUnlock()
+
+	re, ok := namedPatterns[name]
+	return re, ok
+}
+
+// MatchesNamed checks if the val parameter matches the pattern registered
+// under the specified name. Besides the patterns registered using
+// RegisterPattern, the built-in `uuid`, `ulid`, `ksuid`, `request_id`,
+// `e164`, `vat` and `bic` patterns are available. The value can be empty
+// if the required parameter is false.
+func MatchesNamed(val, name string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(val) {
+			return requiredErr(required, "match term cannot be empty")
+		}
+
+		re, ok := lookupPattern(name)
+		if !ok {
+			return newError(CodeInvalid, val, map[string]interface{}{"name": name},
+				"unknown pattern `%s`", name)
+		}
+
+		if !re.MatchString(val) {
+			return newError(CodeMatch, val, map[string]interface{}{"name": name, "pattern": re.expr},
+				"`%s` does not match pattern `%s`", val, name)
+		}
+
+		return nil
+	}
+}
+
+// MatchesRegexp checks if the val parameter matches the precompiled regular
+// expression. The value can be empty if the required parameter is false.
+func MatchesRegexp(val string, re *regexp.Regexp, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(val) {
+			return requiredErr(required, "match term cannot be empty")
+		}
+		if re == nil {
+			return newError(CodeInvalid, val, nil, "regular expression cannot be nil")
+		}
+
+		if !re.MatchString(val) {
+			return newError(CodeMatch, val, map[string]interface{}{"pattern": re.String()},
+				"`%s` does not match pattern `%s`", val, re)
+		}
+
+		return nil
+	}
+}
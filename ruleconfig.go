@@ -0,0 +1,219 @@
+package check
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleConfig contains the rules of a set of fields, read from a declarative
+// rule file (see ParseRules).
+type RuleConfig struct {
+	fields []string
+	rules  map[string][]Rule
+}
+
+// ParseRules reads a rule file, which maps field names to their rules, in
+// YAML or JSON format. The rules of a field are specified either as a list,
+// containing rule names and single-key maps from rule names to parameters,
+// or as a string, in the format of the `check` struct tags. List parameters
+// are joined using `|`:
+//
+//	username:
+//	  - required
+//	  - min_len: 3
+//	  - max_len: 32
+//	role:
+//	  - in: [admin, user]
+//	email: required,email
+//
+// Parameters specified using maps can contain commas (e.g. `match: ^\d{1,3}$`).
+// Returns an error if the file is not valid or references unknown rules.
+func ParseRules(r io.Reader) (*RuleConfig, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &RuleConfig{rules: map[string][]Rule{}}, nil
+		}
+		return nil, ruleFileError(err.Error())
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, ruleNodeError(root, "expected a map of fields to rules")
+	}
+
+	c := &RuleConfig{rules: map[string][]Rule{}}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if _, ok := c.rules[key.Value]; ok {
+			return nil, ruleNodeError(key, "duplicate field `"+key.Value+"`")
+		}
+
+		rules, err := parseRuleNode(value)
+		if err != nil {
+			return nil, err
+		}
+		c.fields = append(c.fields, key.Value)
+		c.rules[key.Value] = rules
+	}
+
+	return c, nil
+}
+
+func parseRuleNode(node *yaml.Node) ([]Rule, error) {
+	var rules []Rule
+	switch node.Kind {
+	case yaml.ScalarNode:
+		tagRules, err := ParseTag(node.Value)
+		if err != nil {
+			return nil, ruleNodeError(node, err.Error())
+		}
+		rules = tagRules
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			switch {
+			case item.Kind == yaml.ScalarNode:
+				tagRules, err := ParseTag(item.Value)
+				if err != nil {
+					return nil, ruleNodeError(item, err.Error())
+				}
+				rules = append(rules, tagRules...)
+			case item.Kind == yaml.MappingNode && len(item.Content) == 2:
+				param, err := ruleParam(item.Content[1])
+				if err != nil {
+					return nil, err
+				}
+				rules = append(rules, Rule{Name: item.Content[0].Value, Param: param})
+			default:
+				return nil, ruleNodeError(item, "expected a rule name or a map from a rule name to its parameter")
+			}
+		}
+	default:
+		return nil, ruleNodeError(node, "expected a list of rules or a rule string")
+	}
+
+	for _, rule := range rules {
+		if !HasRule(rule.Name) {
+			return nil, ruleNodeError(node, "unknown rule `"+rule.Name+"`")
+		}
+	}
+
+	return rules, nil
+}
+
+func ruleParam(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Value, nil
+	case yaml.SequenceNode:
+		elems := make([]string, len(node.Content))
+		for i, elem := range node.Content {
+			if elem.Kind != yaml.ScalarNode {
+				return "", ruleNodeError(elem, "expected a scalar parameter")
+			}
+			elems[i] = elem.Value
+		}
+		return strings.Join(elems, "|"), nil
+	}
+
+	return "", ruleNodeError(node, "expected a scalar or list parameter")
+}
+
+func ruleFileError(msg string) error {
+	return newError(CodeInvalid, nil, nil, "invalid rule file: %s", msg)
+}
+
+func ruleNodeError(node *yaml.Node, msg string) error {
+	return newError(CodeInvalid, nil, map[string]interface{}{"line": node.Line},
+		"invalid rule file: line %d: %s", node.Line, msg)
+}
+
+// Fields returns the names of the fields, in the order in which they are
+// declared in the rule file.
+func (c *RuleConfig) Fields() []string {
+	return append([]string(nil), c.fields...)
+}
+
+// Rules returns the rules of the field with the specified name.
+func (c *RuleConfig) Rules(field string) []Rule {
+	return append([]Rule(nil), c.rules[field]...)
+}
+
+// Validator returns a validator containing the rules of the fields
+// (see Validator).
+func (c *RuleConfig) Validator() *Validator {
+	v := NewValidator()
+	for _, field := range c.fields {
+		v.Rule(field, rulesFunc(c.rules[field], nil))
+	}
+
+	return v
+}
+
+// Validate validates the values of the data map against the rules of their
+// fields. Fields missing from the map are validated as nil values. Returns
+// the first error it encounters.
+func (c *RuleConfig) Validate(data map[string]interface{}) error {
+	return c.Validator().Validate(data)
+}
+
+// ValidateStruct validates the fields of the struct v (or pointer to struct)
+// against their rules. The names of the fields in the rule file are Go field
+// names, and nested fields are referenced using dots (e.g. `Address.City`).
+// Fields referenced through nil pointers are validated as nil values.
+// Returns the first error it encounters.
+func (c *RuleConfig) ValidateStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return newError(CodeInvalid, v, nil, "cannot validate `%v` as struct", rv.Kind())
+	}
+
+	for _, field := range c.fields {
+		x, ok := structFieldValue(rv, field)
+		if !ok {
+			return withField(newError(CodeInvalid, nil, nil, "unknown field `%s`", field), field)
+		}
+		if err := Field(field, rulesFunc(c.rules[field], nil)(x))(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// structFieldValue returns the value of the field of the struct v with the
+// specified dot-separated path. Returns nil if the field is referenced
+// through a nil pointer.
+func structFieldValue(v reflect.Value, path string) (interface{}, bool) {
+	for _, name := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, true
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		sf, ok := v.Type().FieldByName(name)
+		if !ok || !sf.IsExported() {
+			return nil, false
+		}
+		fv, err := v.FieldByIndexErr(sf.Index)
+		if err != nil {
+			// The field is promoted through a nil embedded pointer.
+			return nil, true
+		}
+		v = fv
+	}
+
+	return v.Interface(), true
+}
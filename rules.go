@@ -0,0 +1,508 @@
+package check
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rule represents a validation rule declared in a struct tag. It consists of
+// a name and an optional parameter, separated by `=` (e.g. `min=3`).
+type Rule struct {
+	Name  string
+	Param string
+}
+
+// RuleFunc creates a validation function for the value of a struct field,
+// based on the parameter of the rule it is registered for.
+type RuleFunc func(x interface{}, param string) (ValidateFunc, error)
+
+var (
+	ruleFuncs   = map[string]RuleFunc{}
+	ruleFuncsMu sync.RWMutex
+
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// RegisterRule registers a struct tag rule with the specified name.
+// Registering a rule with the name of an existing rule replaces it.
+func RegisterRule(name string, fn RuleFunc) {
+	ruleFuncsMu.Lock()
+	defer ruleFuncsMu.Unlock()
+
+	ruleFuncs[name] = fn
+}
+
+// HasRule reports whether a struct tag rule with the specified name is
+// registered.
+func HasRule(name string) bool {
+	_, ok := lookupRule(name)
+	return ok
+}
+
+func lookupRule(name string) (RuleFunc, bool) {
+	ruleFuncsMu.RLock()
+	defer ruleFuncsMu.RUnlock()
+
+	fn, ok := ruleFuncs[name]
+	return fn, ok
+}
+
+// ParseTag parses the rules declared in a `check` struct tag
+// (e.g. `required,min_len=3,in=a|b`). The parameter of the `match` rule
+// consists of the rest of the tag, so that regular expressions can contain
+// commas (e.g. `required,match=^\d{1,3}$`). As such, `match` must be the
+// last rule of the tag.
+func ParseTag(tag string) ([]Rule, error) {
+	var rules []Rule
+	for rest := tag; rest != ""; {
+		raw := rest
+		if idx := strings.Index(rest, ","); idx >= 0 {
+			raw, rest = rest[:idx], rest[idx+1:]
+		} else {
+			rest = ""
+		}
+
+		part := strings.TrimSpace(raw)
+		if part == "" {
+			continue
+		}
+
+		var rule Rule
+		if idx := strings.Index(part, "="); idx >= 0 {
+			rule.Name, rule.Param = strings.TrimSpace(part[:idx]), part[idx+1:]
+			if rule.Name == "match" && rest != "" {
+				rule.Param = raw[strings.Index(raw, "=")+1:] + "," + rest
+				rest = ""
+			}
+		} else {
+			rule.Name = part
+		}
+		if rule.Name == "" {
+			return nil, newError(CodeInvalid, tag, nil, "invalid rule `%s` in tag `%s`", part, tag)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func parseParam(t reflect.Type, param string) (interface{}, error) {
+	switch t {
+	case timeType:
+		tm, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			return nil, newError(CodeInvalid, param, nil, "cannot convert `%s` to time.Time", param)
+		}
+		return tm, nil
+	case durationType:
+		d, err := time.ParseDuration(param)
+		if err != nil {
+			return nil, newError(CodeInvalid, param, nil, "cannot convert `%s` to time.Duration", param)
+		}
+		return d, nil
+	}
+
+	if t != nil && reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		// Parse the parameters of custom types, such as the implementations
+		// of Comparer, using their UnmarshalText methods.
+		ptr := reflect.New(t)
+		if err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(param)); err != nil {
+			return nil, newError(CodeInvalid, param, nil, "cannot convert `%s` to type %v: %s", param, t, err)
+		}
+		return ptr.Elem().Interface(), nil
+	}
+
+	v := reflect.New(t).Elem()
+
+	var err error
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(param, 10, t.Bits()); err == nil {
+			v.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		if n, err = strconv.ParseUint(param, 10, t.Bits()); err == nil {
+			v.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		if n, err = strconv.ParseFloat(param, t.Bits()); err == nil {
+			v.SetFloat(n)
+		}
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(param); err == nil {
+			v.SetBool(b)
+		}
+	case reflect.String:
+		v.SetString(param)
+	default:
+		return nil, newError(CodeInvalid, param, nil, "cannot convert `%s` to type %v", param, t)
+	}
+	if err != nil {
+		return nil, newError(CodeInvalid, param, nil, "cannot convert `%s` to type %v", param, t)
+	}
+
+	return v.Interface(), nil
+}
+
+func cmpRule(op cmpOp) RuleFunc {
+	return func(x interface{}, param string) (ValidateFunc, error) {
+		term, err := parseParam(reflect.TypeOf(x), param)
+		if err != nil {
+			return nil, err
+		}
+
+		cmpField, err := newCmpField(op, term)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error {
+			return compare(x, cmpField)
+		}, nil
+	}
+}
+
+func inRule(in bool) RuleFunc {
+	return func(x interface{}, param string) (ValidateFunc, error) {
+		var elems []interface{}
+		for _, p := range strings.Split(param, "|") {
+			elem, err := parseParam(reflect.TypeOf(x), p)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+		}
+
+		if in {
+			return In(x, elems...), nil
+		}
+		return NotIn(x, elems...), nil
+	}
+}
+
+func keysRule(fn func(m interface{}, keys ...interface{}) ValidateFunc) RuleFunc {
+	return func(x interface{}, param string) (ValidateFunc, error) {
+		t := reflect.TypeOf(x)
+		if t == nil || t.Kind() != reflect.Map {
+			return nil, newError(CodeInvalid, x, nil, "rule requires a map value")
+		}
+
+		var keys []interface{}
+		for _, p := range strings.Split(param, "|") {
+			key, err := parseParam(t.Key(), p)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+
+		return fn(x, keys...), nil
+	}
+}
+
+func lenRule(fn func(x interface{}, n int) ValidateFunc) RuleFunc {
+	return func(x interface{}, param string) (ValidateFunc, error) {
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, newError(CodeInvalid, param, nil, "invalid length `%s`", param)
+		}
+
+		return fn(x, n), nil
+	}
+}
+
+func stringRule(name string, fn func(s string) ValidateFunc) RuleFunc {
+	return func(x interface{}, _ string) (ValidateFunc, error) {
+		v := reflect.ValueOf(x)
+		if v.Kind() != reflect.String {
+			return nil, newError(CodeInvalid, x, nil, "rule `%s` requires a string value", name)
+		}
+
+		return fn(v.String()), nil
+	}
+}
+
+func substrRule(name string, fn func(s, substr string) ValidateFunc) RuleFunc {
+	return func(x interface{}, param string) (ValidateFunc, error) {
+		return stringRule(name, func(s string) ValidateFunc {
+			return fn(s, param)
+		})(x, param)
+	}
+}
+
+func init() {
+	RegisterRule("required", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Required(x), nil
+	})
+	RegisterRule("eq", cmpRule(eq))
+	RegisterRule("ne", cmpRule(ne))
+	RegisterRule("lt", cmpRule(lt))
+	RegisterRule("lte", cmpRule(lte))
+	RegisterRule("gt", cmpRule(gt))
+	RegisterRule("gte", cmpRule(gte))
+	RegisterRule("len", lenRule(Len))
+	RegisterRule("min_len", lenRule(MinLen))
+	RegisterRule("max_len", lenRule(MaxLen))
+	RegisterRule("in", inRule(true))
+	RegisterRule("not_in", inRule(false))
+	RegisterRule("positive", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Positive(x), nil
+	})
+	RegisterRule("negative", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Negative(x), nil
+	})
+	RegisterRule("non_negative", func(x interface{}, _ string) (ValidateFunc, error) {
+		return NonNegative(x), nil
+	})
+	RegisterRule("multiple_of", func(x interface{}, param string) (ValidateFunc, error) {
+		n, err := parseParam(reflect.TypeOf(x), param)
+		if err != nil {
+			return nil, err
+		}
+		return MultipleOf(x, n), nil
+	})
+	RegisterRule("unique", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Unique(x), nil
+	})
+	RegisterRule("subset", func(x interface{}, param string) (ValidateFunc, error) {
+		t := reflect.TypeOf(x)
+		if t == nil || (t.Kind() != reflect.Slice && t.Kind() != reflect.Array) {
+			return nil, newError(CodeInvalid, x, nil, "rule `subset` requires a slice or array value")
+		}
+
+		allowed := reflect.MakeSlice(reflect.SliceOf(t.Elem()), 0, 0)
+		for _, p := range strings.Split(param, "|") {
+			elem, err := parseParam(t.Elem(), p)
+			if err != nil {
+				return nil, err
+			}
+			allowed = reflect.Append(allowed, reflect.ValueOf(elem))
+		}
+
+		return Subset(x, allowed.Interface()), nil
+	})
+	RegisterRule("has_keys", keysRule(HasKeys))
+	RegisterRule("only_keys", keysRule(OnlyKeys))
+	RegisterRule("sorted", func(x interface{}, param string) (ValidateFunc, error) {
+		switch param {
+		case "", "asc":
+			return Sorted(x, true), nil
+		case "desc":
+			return Sorted(x, false), nil
+		}
+		return nil, newError(CodeInvalid, param, nil, "invalid sort order `%s`", param)
+	})
+	RegisterRule("match", func(x interface{}, param string) (ValidateFunc, error) {
+		return stringRule("match", func(s string) ValidateFunc {
+			return Matches(s, param, false)
+		})(x, param)
+	})
+	RegisterRule("pattern", func(x interface{}, param string) (ValidateFunc, error) {
+		return stringRule("pattern", func(s string) ValidateFunc {
+			return MatchesNamed(s, param, false)
+		})(x, param)
+	})
+	RegisterRule("alpha", stringRule("alpha", func(s string) ValidateFunc {
+		return Alpha(s, false)
+	}))
+	RegisterRule("alphanumeric", stringRule("alphanumeric", func(s string) ValidateFunc {
+		return Alphanumeric(s, false)
+	}))
+	RegisterRule("numeric", stringRule("numeric", func(s string) ValidateFunc {
+		return Numeric(s, false)
+	}))
+	RegisterRule("ascii", stringRule("ascii", func(s string) ValidateFunc {
+		return ASCII(s, false)
+	}))
+	RegisterRule("printable_ascii", stringRule("printable_ascii", func(s string) ValidateFunc {
+		return PrintableASCII(s, false)
+	}))
+	RegisterRule("lowercase", stringRule("lowercase", func(s string) ValidateFunc {
+		return Lowercase(s, false)
+	}))
+	RegisterRule("uppercase", stringRule("uppercase", func(s string) ValidateFunc {
+		return Uppercase(s, false)
+	}))
+	RegisterRule("prefix", substrRule("prefix", HasPrefix))
+	RegisterRule("suffix", substrRule("suffix", HasSuffix))
+	RegisterRule("contains", substrRule("contains", Contains))
+	RegisterRule("not_contains", substrRule("not_contains", NotContains))
+	RegisterRule("email", stringRule("email", func(s string) ValidateFunc {
+		return Email(s, false)
+	}))
+	RegisterRule("email_list", stringRule("email_list", func(s string) ValidateFunc {
+		return EmailList(s, false)
+	}))
+	RegisterRule("url", stringRule("url", func(s string) ValidateFunc {
+		return URL(s, false)
+	}))
+	RegisterRule("iban", stringRule("iban", func(s string) ValidateFunc {
+		return IBAN(s, false)
+	}))
+	RegisterRule("vat", stringRule("vat", func(s string) ValidateFunc {
+		return VAT(s, false)
+	}))
+	RegisterRule("ip", stringRule("ip", func(s string) ValidateFunc {
+		return IP(s, false)
+	}))
+	RegisterRule("mac", stringRule("mac", func(s string) ValidateFunc {
+		return MAC(s, false)
+	}))
+	RegisterRule("uuid", stringRule("uuid", func(s string) ValidateFunc {
+		return UUID(s, false)
+	}))
+	RegisterRule("ulid", stringRule("ulid", func(s string) ValidateFunc {
+		return ULID(s, false)
+	}))
+	RegisterRule("ksuid", stringRule("ksuid", func(s string) ValidateFunc {
+		return KSUID(s, false)
+	}))
+	RegisterRule("isbn", stringRule("isbn", func(s string) ValidateFunc {
+		return ISBN(s, false)
+	}))
+	RegisterRule("issn", stringRule("issn", func(s string) ValidateFunc {
+		return ISSN(s, false)
+	}))
+	RegisterRule("ean", stringRule("ean", func(s string) ValidateFunc {
+		return EAN(s, false)
+	}))
+	RegisterRule("upc", stringRule("upc", func(s string) ValidateFunc {
+		return UPC(s, false)
+	}))
+	RegisterRule("latitude", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Latitude(x), nil
+	})
+	RegisterRule("longitude", func(x interface{}, _ string) (ValidateFunc, error) {
+		return Longitude(x), nil
+	})
+	RegisterRule("lat_long", stringRule("lat_long", func(s string) ValidateFunc {
+		if isEmptyStr(s) {
+			return func() error { return nil }
+		}
+		return LatLong(s)
+	}))
+	RegisterRule("hex_color", stringRule("hex_color", func(s string) ValidateFunc {
+		return HexColor(s, false)
+	}))
+	RegisterRule("rgb", stringRule("rgb", func(s string) ValidateFunc {
+		return RGB(s, false)
+	}))
+	RegisterRule("rgba", stringRule("rgba", func(s string) ValidateFunc {
+		return RGBA(s, false)
+	}))
+	RegisterRule("hsl", stringRule("hsl", func(s string) ValidateFunc {
+		return HSL(s, false)
+	}))
+	RegisterRule("datetime", func(x interface{}, param string) (ValidateFunc, error) {
+		return stringRule("datetime", func(s string) ValidateFunc {
+			return DateTime(s, param, false)
+		})(x, param)
+	})
+	RegisterRule("date", stringRule("date", func(s string) ValidateFunc {
+		return DateISO8601(s, false)
+	}))
+	RegisterRule("rfc3339", stringRule("rfc3339", func(s string) ValidateFunc {
+		return RFC3339(s, false)
+	}))
+	RegisterRule("timezone", stringRule("timezone", func(s string) ValidateFunc {
+		if isEmptyStr(s) {
+			return func() error { return nil }
+		}
+		return Timezone(s)
+	}))
+	RegisterRule("duration", stringRule("duration", func(s string) ValidateFunc {
+		return Duration(s, false)
+	}))
+	RegisterRule("phone", stringRule("phone", func(s string) ValidateFunc {
+		return Phone(s, false)
+	}))
+	RegisterRule("bic", stringRule("bic", func(s string) ValidateFunc {
+		return BIC(s, false)
+	}))
+	RegisterRule("private_ip", stringRule("private_ip", func(s string) ValidateFunc {
+		return PrivateIP(s, false)
+	}))
+	RegisterRule("public_ip", stringRule("public_ip", func(s string) ValidateFunc {
+		return PublicIP(s, false)
+	}))
+	RegisterRule("loopback_ip", stringRule("loopback_ip", func(s string) ValidateFunc {
+		return LoopbackIP(s, false)
+	}))
+	RegisterRule("country", stringRule("country", func(s string) ValidateFunc {
+		return CountryCode(s, Alpha2, false)
+	}))
+	RegisterRule("currency", stringRule("currency", func(s string) ValidateFunc {
+		return CurrencyCode(s, false)
+	}))
+	RegisterRule("language", stringRule("language", func(s string) ValidateFunc {
+		return LanguageCode(s, false)
+	}))
+	RegisterRule("credit_card", stringRule("credit_card", func(s string) ValidateFunc {
+		return CreditCard(s, false)
+	}))
+	RegisterRule("json", stringRule("json", func(s string) ValidateFunc {
+		return JSON(s, false)
+	}))
+	RegisterRule("yaml", stringRule("yaml", func(s string) ValidateFunc {
+		return YAML(s, false)
+	}))
+	RegisterRule("xml", stringRule("xml", func(s string) ValidateFunc {
+		return XML(s, false)
+	}))
+	RegisterRule("base64", stringRule("base64", func(s string) ValidateFunc {
+		return Base64(s, false)
+	}))
+	RegisterRule("base64url", stringRule("base64url", func(s string) ValidateFunc {
+		return Base64URL(s, false)
+	}))
+	RegisterRule("hex", stringRule("hex", func(s string) ValidateFunc {
+		return Hex(s, false)
+	}))
+	RegisterRule("base58", stringRule("base58", func(s string) ValidateFunc {
+		return Base58(s, false)
+	}))
+	RegisterRule("semver", stringRule("semver", func(s string) ValidateFunc {
+		return Semver(s, false)
+	}))
+	RegisterRule("semver_gte", substrRule("semver_gte", func(s, min string) ValidateFunc {
+		if isEmptyStr(s) {
+			return Semver(s, false)
+		}
+		return SemverGte(s, min)
+	}))
+	RegisterRule("semver_lt", substrRule("semver_lt", func(s, max string) ValidateFunc {
+		if isEmptyStr(s) {
+			return Semver(s, false)
+		}
+		return SemverLt(s, max)
+	}))
+	RegisterRule("hash", func(x interface{}, param string) (ValidateFunc, error) {
+		return stringRule("hash", func(s string) ValidateFunc {
+			return Hash(s, param, false)
+		})(x, param)
+	})
+	RegisterRule("decimal", func(x interface{}, param string) (ValidateFunc, error) {
+		p, sc, _ := strings.Cut(param, "|")
+		precision, perr := strconv.Atoi(p)
+		scale, serr := strconv.Atoi(sc)
+		if perr != nil || serr != nil {
+			return nil, newError(CodeInvalid, param, nil, "invalid decimal precision and scale `%s`", param)
+		}
+
+		return stringRule("decimal", func(s string) ValidateFunc {
+			return Decimal(s, precision, scale, false)
+		})(x, param)
+	})
+}
@@ -0,0 +1,184 @@
+package check
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Result contains the outcome of a validation run.
+type Result struct {
+	Valid  bool     `json:"valid"`
+	Errors []*Error `json:"errors"`
+}
+
+// MarshalJSON encodes the result as JSON. The errors of valid results are
+// encoded as an empty list, instead of null.
+func (r *Result) MarshalJSON() ([]byte, error) {
+	type result Result
+
+	rc := result(*r)
+	if rc.Errors == nil {
+		rc.Errors = []*Error{}
+	}
+
+	return json.Marshal(rc)
+}
+
+// Err returns the errors of the result as Errors, or nil if the result
+// is valid.
+func (r *Result) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	errs := make(Errors, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = e
+	}
+
+	return errs
+}
+
+type fieldRule[T any] struct {
+	field string
+	deps  []string
+	fn    func(x T) ValidateFunc
+}
+
+// RuleSet contains the validation rules of the fields of an input of type T.
+// Rules are created from the validated input each time they are executed,
+// which allows the set to be reused and the input to be revalidated
+// incrementally, as its fields change.
+type RuleSet[T any] struct {
+	rules []fieldRule[T]
+}
+
+// NewRuleSet returns a new empty rule set.
+func NewRuleSet[T any]() *RuleSet[T] {
+	return &RuleSet[T]{}
+}
+
+// Field adds a rule for the field with the specified path. The rule is
+// executed again when the field or any of the specified dependencies change
+// (e.g. a `confirm_password` rule which depends on `password`).
+// Returns the rule set, so that calls can be chained.
+func (rs *RuleSet[T]) Field(path string, fn func(x T) ValidateFunc, deps ...string) *RuleSet[T] {
+	rs.rules = append(rs.rules, fieldRule[T]{field: path, deps: deps, fn: fn})
+	return rs
+}
+
+// Validate executes all the rules of the set and returns the result.
+// The rules of each field are executed until the first one fails.
+func (rs *RuleSet[T]) Validate(x T) *Result {
+	return rs.run(x, func(string) bool { return true }, nil)
+}
+
+// Revalidate executes only the rules affected by the changed field paths and
+// merges their result with the previous one. The rules of a field are
+// affected if the field, or any of the dependencies of its rules, is one of
+// the changed paths, a parent or a descendant of one (e.g. a change of
+// `address` affects the `address.city` rules). The errors of unaffected
+// fields are taken from the previous result.
+func (rs *RuleSet[T]) Revalidate(x T, prev *Result, changed ...string) *Result {
+	affected := map[string]bool{}
+	for _, rule := range rs.rules {
+		for _, path := range append([]string{rule.field}, rule.deps...) {
+			if pathsOverlap(path, changed) {
+				affected[rule.field] = true
+			}
+		}
+	}
+
+	return rs.run(x, func(field string) bool { return affected[field] }, prev)
+}
+
+// Dependencies returns the dependency graph of the fields of the rule set.
+// The keys are the paths of the fields and of the declared dependencies,
+// and the values are the paths of the fields whose rules must be executed
+// again when the key changes, in ascending order. Each field depends on
+// itself. Changes of the parents or descendants of the keys should be
+// treated as changes of the keys (see Revalidate).
+func (rs *RuleSet[T]) Dependencies() map[string][]string {
+	graph := map[string]map[string]bool{}
+	for _, rule := range rs.rules {
+		for _, path := range append([]string{rule.field}, rule.deps...) {
+			if graph[path] == nil {
+				graph[path] = map[string]bool{}
+			}
+			graph[path][rule.field] = true
+		}
+	}
+
+	deps := make(map[string][]string, len(graph))
+	for path, fields := range graph {
+		for field := range fields {
+			deps[path] = append(deps[path], field)
+		}
+		sort.Strings(deps[path])
+	}
+
+	return deps
+}
+
+func (rs *RuleSet[T]) run(x T, affected func(field string) bool, prev *Result) *Result {
+	result := &Result{}
+
+	fields := map[string]bool{}
+	for _, rule := range rs.rules {
+		fields[rule.field] = true
+	}
+
+	done := map[string]bool{}
+	for _, rule := range rs.rules {
+		if !affected(rule.field) {
+			if !done[rule.field] && prev != nil {
+				result.Errors = append(result.Errors, fieldErrors(prev.Errors, rule.field, fields)...)
+			}
+			done[rule.field] = true
+			continue
+		}
+		if done[rule.field] {
+			continue
+		}
+		if err := rule.fn(x)(); err != nil {
+			done[rule.field] = true
+			result.Errors = append(result.Errors, withField(err, rule.field))
+		}
+	}
+	result.Valid = len(result.Errors) == 0
+
+	return result
+}
+
+// fieldErrors returns the errors of the field with the specified path,
+// including the errors of its descendants which have no rules of their own.
+func fieldErrors(errs []*Error, path string, fields map[string]bool) []*Error {
+	var fieldErrs []*Error
+	for _, e := range errs {
+		if e.Field == path || (isSubpath(e.Field, path) && !fields[e.Field]) {
+			fieldErrs = append(fieldErrs, e)
+		}
+	}
+
+	return fieldErrs
+}
+
+func pathsOverlap(path string, paths []string) bool {
+	for _, p := range paths {
+		if p == path || isSubpath(path, p) || isSubpath(p, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSubpath(path, parent string) bool {
+	if !strings.HasPrefix(path, parent) || len(path) == len(parent) {
+		return false
+	}
+
+	c := path[len(parent)]
+	return c == '.' || c == '['
+}
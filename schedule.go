@@ -0,0 +1,56 @@
+package check
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeWindow represents a time interval delimited by a start and an end time.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NoOverlap checks if the specified time windows do not overlap. The end of
+// each window must be after its start. If allowAdjacent is true, a window is
+// allowed to start at the exact time the previous one ends.
+// The returned error contains the indices of the conflicting windows or the
+// index of the window which does not end after its start.
+func NoOverlap(windows []TimeWindow, allowAdjacent bool) ValidateFunc {
+	return func() error {
+		idxs := make([]int, len(windows))
+		for i, window := range windows {
+			if !window.End.After(window.Start) {
+				return newError(CodeTimeWindow, windows, map[string]interface{}{
+					"index": i,
+				}, "time window `%d` does not end after its start", i)
+			}
+			idxs[i] = i
+		}
+
+		sort.SliceStable(idxs, func(i, j int) bool {
+			return windows[idxs[i]].Start.Before(windows[idxs[j]].Start)
+		})
+
+		for i := 1; i < len(idxs); i++ {
+			prev, curr := idxs[i-1], idxs[i]
+
+			end, start := windows[prev].End, windows[curr].Start
+			if start.After(end) || (allowAdjacent && start.Equal(end)) {
+				continue
+			}
+
+			first, second := prev, curr
+			if first > second {
+				first, second = second, first
+			}
+
+			return newError(CodeOverlap, windows, map[string]interface{}{
+				"first":  first,
+				"second": second,
+			}, "time windows `%d` and `%d` overlap", first, second)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,216 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+)
+
+// semver represents a parsed semantic version. The build metadata is
+// omitted, as it does not affect precedence.
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// parseSemver parses a version in the format defined by the Semantic
+// Versioning 2.0.0 specification (e.g. `1.4.2`, `2.0.0-rc.1+build.5`).
+func parseSemver(s string) (semver, bool) {
+	var v semver
+
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild && !validSemverIdents(build, false) {
+		return v, false
+	}
+	s, pre, hasPre := strings.Cut(s, "-")
+	if hasPre {
+		if !validSemverIdents(pre, true) {
+			return v, false
+		}
+		v.pre = strings.Split(pre, ".")
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return v, false
+	}
+	nums := []*uint64{&v.major, &v.minor, &v.patch}
+	for i, part := range parts {
+		if !isSemverNumber(part) {
+			return v, false
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return v, false
+		}
+		*nums[i] = n
+	}
+
+	return v, true
+}
+
+// validSemverIdents reports whether s is a dot separated list of non-empty
+// identifiers, consisting of alphanumerics and hyphens. If numeric is true,
+// numeric identifiers must not contain leading zeros.
+func validSemverIdents(s string, numeric bool) bool {
+	for _, ident := range strings.Split(s, ".") {
+		if ident == "" {
+			return false
+		}
+		for _, r := range ident {
+			if r != '-' && !isAlphanumeric(r) {
+				return false
+			}
+		}
+		if numeric && isDigits(ident) && !isSemverNumber(ident) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSemverNumber(s string) bool {
+	return isDigits(s) && (len(s) == 1 || s[0] != '0')
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compare returns -1, 0 or 1 if v has a lower, equal or higher precedence
+// than w.
+func (v semver) compare(w semver) int {
+	for _, p := range [][2]uint64{{v.major, w.major}, {v.minor, w.minor}, {v.patch, w.patch}} {
+		if p[0] != p[1] {
+			if p[0] < p[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A pre-release version has a lower precedence than a normal version.
+	switch {
+	case len(v.pre) == 0 && len(w.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(w.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(w.pre); i++ {
+		if c := compareSemverIdent(v.pre[i], w.pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.pre) < len(w.pre):
+		return -1
+	case len(v.pre) > len(w.pre):
+		return 1
+	}
+
+	return 0
+}
+
+// compareSemverIdent compares two pre-release identifiers. Numeric
+// identifiers are compared numerically and have a lower precedence than
+// alphanumeric identifiers, which are compared lexically.
+func compareSemverIdent(a, b string) int {
+	aNum, bNum := isDigits(a), isDigits(b)
+	switch {
+	case aNum && bNum:
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// Semver checks if the s parameter is a valid semantic version, as defined
+// by the Semantic Versioning 2.0.0 specification (e.g. `1.4.2`,
+// `2.0.0-rc.1+build.5`). The version can be empty if the required
+// parameter is false.
+func Semver(s string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(s) {
+			return requiredErr(required, "version cannot be empty")
+		}
+		if _, ok := parseSemver(s); !ok {
+			return newError(CodeSemver, s, nil, "invalid semantic version `%s`", s)
+		}
+
+		return nil
+	}
+}
+
+// SemverGte checks if the s parameter is a semantic version with a
+// precedence greater than or equal to the min version.
+func SemverGte(s, min string) ValidateFunc {
+	return semverValidator(s, min, "")
+}
+
+// SemverLt checks if the s parameter is a semantic version with a
+// precedence less than the max version.
+func SemverLt(s, max string) ValidateFunc {
+	return semverValidator(s, "", max)
+}
+
+// SemverInRange checks if the s parameter is a semantic version with a
+// precedence greater than or equal to the min version and less than the
+// max version (e.g. `>=1.2.0 <2.0.0`).
+func SemverInRange(s, min, max string) ValidateFunc {
+	return semverValidator(s, min, max)
+}
+
+func semverValidator(s, min, max string) ValidateFunc {
+	return func() error {
+		v, ok := parseSemver(s)
+		if !ok {
+			return newError(CodeSemver, s, nil, "invalid semantic version `%s`", s)
+		}
+
+		if min != "" {
+			minVer, ok := parseSemver(min)
+			if !ok {
+				return newError(CodeInvalid, s, map[string]interface{}{"min": min},
+					"invalid semantic version `%s`", min)
+			}
+			if v.compare(minVer) < 0 {
+				return newError(CodeGte, s, map[string]interface{}{"min": min},
+					"version `%s` is lower than `%s`", s, min)
+			}
+		}
+		if max != "" {
+			maxVer, ok := parseSemver(max)
+			if !ok {
+				return newError(CodeInvalid, s, map[string]interface{}{"max": max},
+					"invalid semantic version `%s`", max)
+			}
+			if v.compare(maxVer) >= 0 {
+				return newError(CodeLt, s, map[string]interface{}{"max": max},
+					"version `%s` is not lower than `%s`", s, max)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Sorted checks if the elements of the slice or array x are sorted in
+// ascending or descending order. Equal adjacent elements are allowed. The
+// elements are compared like the values passed to Lt and Gt, so they can be
+// numbers, strings, times or implementations of Comparer. Returns an error
+// for the first element out of order, with the Field of the error set to its
+// index (e.g. `[2]`).
+func Sorted(x interface{}, ascending bool) ValidateFunc {
+	return func() error {
+		order := "descending"
+		op := lte
+		if ascending {
+			order, op = "ascending", gte
+		}
+
+		return checkSequence(x, op, CodeSorted, "elements are not sorted in "+order+" order")
+	}
+}
+
+// Monotonic checks if the elements of the slice or array x are increasing
+// or decreasing. If strict is true, equal adjacent elements are not allowed.
+// The elements are compared like the values passed to Lt and Gt. Returns an
+// error for the first element which breaks the monotony, with the Field of
+// the error set to its index (e.g. `[2]`).
+func Monotonic(x interface{}, increasing, strict bool) ValidateFunc {
+	return func() error {
+		var desc string
+		var op cmpOp
+		switch {
+		case increasing && strict:
+			desc, op = "strictly increasing", gt
+		case increasing:
+			desc, op = "non-decreasing", gte
+		case strict:
+			desc, op = "strictly decreasing", lt
+		default:
+			desc, op = "non-increasing", lte
+		}
+
+		return checkSequence(x, op, CodeMonotonic, "elements are not "+desc)
+	}
+}
+
+// checkSequence checks if every element of the slice or array x satisfies
+// the comparison operator, when compared to the previous element.
+func checkSequence(x interface{}, op cmpOp, code, msg string) error {
+	v := reflect.ValueOf(x)
+	if kind := v.Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return newError(CodeInvalid, x, nil, "cannot iterate over `%v`", kind)
+	}
+
+	for i := 1; i < v.Len(); i++ {
+		prev, elem := v.Index(i-1).Interface(), v.Index(i).Interface()
+
+		err := compare(elem, &cmpField{op: op, term: prev})
+		if err == nil {
+			continue
+		}
+
+		var e *Error
+		if !errors.As(err, &e) || e.Code != cmpCodes[op] {
+			return withField(err, fmt.Sprintf("[%d]", i))
+		}
+
+		err = newError(code, elem, map[string]interface{}{"previous": prev},
+			"%s: `%v` follows `%v`", msg, elem, prev)
+		return withField(err, fmt.Sprintf("[%d]", i))
+	}
+
+	return nil
+}
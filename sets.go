@@ -0,0 +1,230 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Subset checks if every element of the slice or array x is an element of
+// the slice or array allowed. Returns an error for the first element which
+// is not allowed, with the Field of the error set to its index (e.g. `[2]`).
+func Subset(x, allowed interface{}) ValidateFunc {
+	return func() error {
+		xv, av, err := setValues(x, allowed)
+		if err != nil {
+			return err
+		}
+
+		index := newElemIndex(av)
+		for i := 0; i < xv.Len(); i++ {
+			elem := xv.Index(i).Interface()
+			if index.find(elem) < 0 {
+				err := newError(CodeSubset, elem, map[string]interface{}{"allowed": allowed},
+					"`subset` check failed: `%v` not in `%v`", elem, allowed)
+				return withField(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return nil
+	}
+}
+
+// Superset checks if the slice or array x contains every element of the
+// slice or array required. Returns an error for the first missing element.
+func Superset(x, required interface{}) ValidateFunc {
+	return func() error {
+		xv, rv, err := setValues(x, required)
+		if err != nil {
+			return err
+		}
+
+		index := newElemIndex(xv)
+		for i := 0; i < rv.Len(); i++ {
+			elem := rv.Index(i).Interface()
+			if index.find(elem) < 0 {
+				return newError(CodeSuperset, x, map[string]interface{}{"missing": elem},
+					"`superset` check failed: `%v` does not contain `%v`", x, elem)
+			}
+		}
+
+		return nil
+	}
+}
+
+// Disjoint checks if the slices or arrays x and other have no elements in
+// common. Returns an error for the first element of x which is contained in
+// other, with the Field of the error set to its index (e.g. `[2]`).
+func Disjoint(x, other interface{}) ValidateFunc {
+	return func() error {
+		xv, ov, err := setValues(x, other)
+		if err != nil {
+			return err
+		}
+
+		index := newElemIndex(ov)
+		for i := 0; i < xv.Len(); i++ {
+			elem := xv.Index(i).Interface()
+			if index.find(elem) >= 0 {
+				err := newError(CodeDisjoint, elem, map[string]interface{}{"other": other},
+					"`disjoint` check failed: `%v` found in `%v`", elem, other)
+				return withField(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return nil
+	}
+}
+
+// Unique checks if the slice or array x does not contain duplicate elements.
+// Returns an error for the first duplicate, with the Field of the error set
+// to its index (e.g. `[2]`) and the index of the first occurrence of the
+// element stored in the `index` parameter.
+func Unique(x interface{}) ValidateFunc {
+	return func() error {
+		xv, err := setValue(x)
+		if err != nil {
+			return err
+		}
+
+		index := newElemIndex(reflect.Value{})
+		for i := 0; i < xv.Len(); i++ {
+			elem := xv.Index(i).Interface()
+			if j := index.find(elem); j >= 0 {
+				err := newError(CodeUnique, elem, map[string]interface{}{"index": j},
+					"`unique` check failed: `%v` is a duplicate of the element at index `%d`", elem, j)
+				return withField(err, fmt.Sprintf("[%d]", i))
+			}
+			index.add(elem, i)
+		}
+
+		return nil
+	}
+}
+
+func setValue(x interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Slice:
+		return v, nil
+	case reflect.Array:
+		// Copy arrays, in order to be able to slice them.
+		s := reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		return s, nil
+	}
+
+	return reflect.Value{}, newError(CodeInvalid, x, nil, "cannot use `%v` as a set", v.Kind())
+}
+
+func setValues(x, y interface{}) (reflect.Value, reflect.Value, error) {
+	xv, err := setValue(x)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+	yv, err := setValue(y)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+
+	return xv, yv, nil
+}
+
+// elemIndex finds the index of the first occurrence of an element in a set.
+// Elements of types for which the == operator matches reflect.DeepEqual
+// (e.g. numbers, strings and structs consisting of them) are looked up in a
+// map. Other elements (e.g. pointers and slices) are compared one by one,
+// using reflect.DeepEqual.
+type elemIndex struct {
+	indexes  map[interface{}]int
+	elems    []interface{}
+	elemIdxs []int
+	hashable map[reflect.Type]bool
+}
+
+// newElemIndex returns an index of the elements of the slice v. If v is
+// not valid, the returned index is empty.
+func newElemIndex(v reflect.Value) *elemIndex {
+	index := &elemIndex{
+		indexes:  map[interface{}]int{},
+		hashable: map[reflect.Type]bool{},
+	}
+	if v.IsValid() {
+		for i := 0; i < v.Len(); i++ {
+			index.add(v.Index(i).Interface(), i)
+		}
+	}
+
+	return index
+}
+
+// add adds the element x, found at index i, unless the index already
+// contains an equal element.
+func (ix *elemIndex) add(x interface{}, i int) {
+	if ix.find(x) >= 0 {
+		return
+	}
+	if ix.isHashable(x) {
+		ix.indexes[x] = i
+		return
+	}
+
+	ix.elems = append(ix.elems, x)
+	ix.elemIdxs = append(ix.elemIdxs, i)
+}
+
+// find returns the index of the first element equal to x, or -1 if there
+// is no such element.
+func (ix *elemIndex) find(x interface{}) int {
+	if ix.isHashable(x) {
+		if i, ok := ix.indexes[x]; ok {
+			return i
+		}
+		return -1
+	}
+
+	for j, elem := range ix.elems {
+		if equal(elem, x) {
+			return ix.elemIdxs[j]
+		}
+	}
+
+	return -1
+}
+
+func (ix *elemIndex) isHashable(x interface{}) bool {
+	if x == nil {
+		return true
+	}
+
+	t := reflect.TypeOf(x)
+	hashable, ok := ix.hashable[t]
+	if !ok {
+		hashable = isHashableType(t)
+		ix.hashable[t] = hashable
+	}
+
+	return hashable
+}
+
+// isHashableType reports whether the values of type t can be compared
+// using the == operator with the same result as reflect.DeepEqual.
+func isHashableType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Array:
+		return isHashableType(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isHashableType(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
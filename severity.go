@@ -0,0 +1,99 @@
+package check
+
+import "errors"
+
+// Warn executes the validation functions and marks the first error it
+// encounters as a warning, by setting its severity to SeverityWarning.
+// Warnings describe soft rules, which do not invalidate the validated
+// values (see RunWarnings). Errors of type Errors are marked as warnings
+// element-wise. Errors which are not of type *Error are converted to an
+// *Error with the CodeInvalid code.
+func Warn(vfs ...ValidateFunc) ValidateFunc {
+	return func() error {
+		err := run(vfs...)
+		if err == nil {
+			return nil
+		}
+
+		return asWarning(err)
+	}
+}
+
+func asWarning(err error) error {
+	if errs, ok := err.(Errors); ok {
+		warnings := make(Errors, len(errs))
+		for i, err := range errs {
+			warnings[i] = asWarning(err)
+		}
+		return warnings
+	}
+
+	e := toError(err)
+	e.Severity = SeverityWarning
+	return e
+}
+
+// IsWarning reports whether err is a warning (see Warn). Errors of type
+// Errors are warnings if all the contained errors are warnings.
+func IsWarning(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, err := range flattenErrors(err) {
+		var e *Error
+		if !errors.As(err, &e) || e.severity() != SeverityWarning {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RunWarnings executes a list of validation functions, continuing past
+// warnings and stopping at the first error. It returns the warnings it
+// encounters and the error which stopped the run, if any. Validation
+// functions returning both warnings and errors, as Errors, stop the run
+// and the returned error contains only the errors.
+//
+//	warnings, err := check.RunWarnings(
+//		check.Required(row.SKU),
+//		check.Warn(check.MaxLen(row.Description, 200)),
+//	)
+func RunWarnings(vfs ...ValidateFunc) (Errors, error) {
+	var warnings Errors
+	for _, vf := range vfs {
+		err := runCheck(vf, vf)
+		if err == nil {
+			continue
+		}
+
+		var errs Errors
+		for _, err := range flattenErrors(err) {
+			if IsWarning(err) {
+				warnings = append(warnings, err)
+			} else {
+				errs = append(errs, err)
+			}
+		}
+
+		switch len(errs) {
+		case 0:
+			continue
+		case 1:
+			return warnings, errs[0]
+		}
+		return warnings, errs
+	}
+
+	return warnings, nil
+}
+
+// severity returns the severity of the error, defaulting to SeverityError.
+func (e *Error) severity() Severity {
+	if e.Severity == "" {
+		return SeverityError
+	}
+
+	return e.Severity
+}
@@ -0,0 +1,144 @@
+package check
+
+import (
+	"sort"
+	"sync"
+)
+
+const defaultMaxExamples = 3
+
+// StatsEntry contains the aggregated failures of a field for a specific
+// error code.
+type StatsEntry struct {
+	Field    string        `json:"field,omitempty"`
+	Code     string        `json:"code"`
+	Count    int           `json:"count"`
+	Examples []interface{} `json:"examples,omitempty"`
+}
+
+// StatsSummary summarizes the failures recorded by a stats collector.
+type StatsSummary struct {
+	Checks   int          `json:"checks"`
+	Failures int          `json:"failures"`
+	Entries  []StatsEntry `json:"entries"`
+}
+
+type statsKey struct {
+	field string
+	code  string
+}
+
+// Stats aggregates validation failures by field and error code, in order
+// to provide data quality statistics (e.g. after validating large imports).
+// Slice, array and map indexes are removed from the field paths, so that
+// failures of the same field of different items are aggregated together.
+// It is safe for concurrent use.
+type Stats struct {
+	// MaxExamples is the maximum number of example values recorded for each
+	// field and error code. Defaults to 3.
+	MaxExamples int
+
+	// Redact returns a version of the invalid value of the field which is
+	// safe to be stored. If nil, example values are not recorded.
+	Redact func(field string, value interface{}) interface{}
+
+	mu       sync.Mutex
+	checks   int
+	failures int
+	entries  map[statsKey]*StatsEntry
+}
+
+// Wrap returns a validation function which executes vf and records
+// its result.
+func (s *Stats) Wrap(vf ValidateFunc) ValidateFunc {
+	return func() error {
+		err := vf()
+		s.Observe(err)
+		return err
+	}
+}
+
+// Observe records the result of a validation function. Errors of type
+// Errors are recorded as separate failures.
+func (s *Stats) Observe(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checks++
+	if err == nil {
+		return
+	}
+	s.failures++
+
+	for _, err := range flattenErrors(err) {
+		s.record(toError(err))
+	}
+}
+
+// ObserveBatch records the results of the validation of a batch of items.
+// Each item counts as a check.
+func (s *Stats) ObserveBatch(r *BatchResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checks += r.Total
+	s.failures += len(r.Errors)
+	for _, idx := range r.Invalid() {
+		for _, err := range flattenErrors(r.Errors[idx]) {
+			s.record(toError(err))
+		}
+	}
+}
+
+func (s *Stats) record(e *Error) {
+	key := statsKey{field: regPathIndex.ReplaceAllString(e.Field, ""), code: e.Code}
+	if s.entries == nil {
+		s.entries = map[statsKey]*StatsEntry{}
+	}
+
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = &StatsEntry{Field: key.field, Code: key.code}
+		s.entries[key] = entry
+	}
+	entry.Count++
+
+	maxExamples := s.MaxExamples
+	if maxExamples <= 0 {
+		maxExamples = defaultMaxExamples
+	}
+	if s.Redact != nil && len(entry.Examples) < maxExamples {
+		entry.Examples = append(entry.Examples, s.Redact(key.field, e.Value))
+	}
+}
+
+// Summary returns the recorded statistics. The entries are sorted in
+// descending order of their failure count.
+func (s *Stats) Summary() StatsSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	summary := StatsSummary{
+		Checks:   s.checks,
+		Failures: s.failures,
+		Entries:  make([]StatsEntry, 0, len(s.entries)),
+	}
+	for _, entry := range s.entries {
+		e := *entry
+		e.Examples = append([]interface{}(nil), entry.Examples...)
+		summary.Entries = append(summary.Entries, e)
+	}
+
+	sort.Slice(summary.Entries, func(i, j int) bool {
+		a, b := summary.Entries[i], summary.Entries[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.Code < b.Code
+	})
+
+	return summary
+}
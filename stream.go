@@ -0,0 +1,138 @@
+package check
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// RecordResult contains the result of the validation of a record of
+// a stream of newline-delimited JSON documents.
+type RecordResult struct {
+	// Line is the line number of the record, starting from 1.
+	Line int
+
+	// Record is the decoded record. It is nil if the record could not be
+	// decoded.
+	Record interface{}
+
+	// Err is the validation error of the record, if any, as a *RecordError.
+	Err error
+}
+
+// RecordError is the error of an invalid record of a stream of
+// newline-delimited JSON documents.
+type RecordError struct {
+	// Line is the line number of the record, starting from 1.
+	Line int
+
+	// Err is the decoding error or the validation error of the record.
+	Err error
+}
+
+// Error returns the message of the error, prefixed by the line number.
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the decoding error or the validation error of the record.
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// StreamJSON reads newline-delimited JSON documents (JSON Lines) from r,
+// one record at a time, and validates them using the specified validators.
+// The records are decoded as maps and all the errors of each record are
+// reported, as Errors (see Validator.Report). Blank lines are skipped.
+//
+// The results are sent on the returned channel, in order, which is closed
+// after the last record is read. If r cannot be read, the error is sent as
+// the last result, without a record. In order to stop reading early, cancel
+// ctx, after which the channel is closed without sending the remaining
+// results. Either the channel must be drained or ctx cancelled, in order to
+// release the resources used for reading.
+func StreamJSON(ctx context.Context, r io.Reader, validators ...*Validator) <-chan RecordResult {
+	return streamJSON(ctx, r, func(line []byte) (interface{}, error) {
+		var record map[string]interface{}
+		if err := json.Unmarshal(line, &record); err != nil {
+			e := newError(CodeJSON, nil, nil, "invalid JSON document: %s", err)
+			e.Err = err
+			return nil, e
+		}
+
+		var errs Errors
+		for _, v := range validators {
+			for _, err := range v.Report(record).Errors {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return record, errs
+		}
+
+		return record, nil
+	})
+}
+
+// StreamJSONStruct reads newline-delimited JSON documents (JSON Lines) from
+// r, like StreamJSON, and decodes each record into a new value of type T,
+// which is validated like the values decoded by UnmarshalJSON. The records
+// are sent on the returned channel as values of type *T.
+func StreamJSONStruct[T any](ctx context.Context, r io.Reader) <-chan RecordResult {
+	return streamJSON(ctx, r, func(line []byte) (interface{}, error) {
+		record := new(T)
+		if err := UnmarshalJSON(line, record); err != nil {
+			var e *Error
+			if errors.As(err, &e) && e.Code == CodeJSON {
+				return nil, err
+			}
+			return record, err
+		}
+
+		return record, nil
+	})
+}
+
+func streamJSON(ctx context.Context, r io.Reader, decode func(line []byte) (interface{}, error)) <-chan RecordResult {
+	results := make(chan RecordResult)
+	go func() {
+		defer close(results)
+
+		send := func(result RecordResult) bool {
+			select {
+			case results <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		br := bufio.NewReader(r)
+		for n := 1; ctx.Err() == nil; n++ {
+			line, err := br.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) > 0 {
+				result := RecordResult{Line: n}
+				result.Record, result.Err = decode(line)
+				if result.Err != nil {
+					result.Err = &RecordError{Line: n, Err: result.Err}
+				}
+				if !send(result) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				send(RecordResult{Line: n, Err: &RecordError{Line: n, Err: err}})
+				return
+			}
+		}
+	}()
+
+	return results
+}
@@ -0,0 +1,75 @@
+package check
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+)
+
+// substrValidator returns a validation function which checks if the
+// predicate is satisfied by the s and substr parameters. If fold is true,
+// both parameters are case folded before being passed to the predicate.
+func substrValidator(s, substr string, fold bool, code, format string, pred func(s, substr string) bool) ValidateFunc {
+	return func() error {
+		x, y := s, substr
+		if fold {
+			caser := cases.Fold()
+			x, y = caser.String(x), caser.String(y)
+		}
+		if !pred(x, y) {
+			params := map[string]interface{}{"substr": substr, "fold": fold}
+			return newError(code, s, params, format, s, substr)
+		}
+
+		return nil
+	}
+}
+
+// HasPrefix checks if the s parameter starts with the specified prefix.
+func HasPrefix(s, prefix string) ValidateFunc {
+	return substrValidator(s, prefix, false, CodePrefix, "`%s` does not start with `%s`", strings.HasPrefix)
+}
+
+// HasPrefixFold checks if the s parameter starts with the specified prefix,
+// ignoring case.
+func HasPrefixFold(s, prefix string) ValidateFunc {
+	return substrValidator(s, prefix, true, CodePrefix, "`%s` does not start with `%s`", strings.HasPrefix)
+}
+
+// HasSuffix checks if the s parameter ends with the specified suffix.
+func HasSuffix(s, suffix string) ValidateFunc {
+	return substrValidator(s, suffix, false, CodeSuffix, "`%s` does not end with `%s`", strings.HasSuffix)
+}
+
+// HasSuffixFold checks if the s parameter ends with the specified suffix,
+// ignoring case.
+func HasSuffixFold(s, suffix string) ValidateFunc {
+	return substrValidator(s, suffix, true, CodeSuffix, "`%s` does not end with `%s`", strings.HasSuffix)
+}
+
+// Contains checks if the s parameter contains the specified substring.
+func Contains(s, substr string) ValidateFunc {
+	return substrValidator(s, substr, false, CodeContains, "`%s` does not contain `%s`", strings.Contains)
+}
+
+// ContainsFold checks if the s parameter contains the specified substring,
+// ignoring case.
+func ContainsFold(s, substr string) ValidateFunc {
+	return substrValidator(s, substr, true, CodeContains, "`%s` does not contain `%s`", strings.Contains)
+}
+
+// NotContains checks if the s parameter does not contain the specified
+// substring.
+func NotContains(s, substr string) ValidateFunc {
+	return substrValidator(s, substr, false, CodeNotContains, "`%s` contains `%s`", notContains)
+}
+
+// NotContainsFold checks if the s parameter does not contain the specified
+// substring, ignoring case.
+func NotContainsFold(s, substr string) ValidateFunc {
+	return substrValidator(s, substr, true, CodeNotContains, "`%s` contains `%s`", notContains)
+}
+
+func notContains(s, substr string) bool {
+	return !strings.Contains(s, substr)
+}
@@ -0,0 +1,291 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var regPathIndex = regexp.MustCompile(`\[[^\]]*\]`)
+
+// Struct validates the fields of the struct v (or pointer to struct) based
+// on the rules declared in their `check` struct tags. The rules of a field
+// are separated by commas and rule parameters follow an equal sign:
+//
+//	type User struct {
+//		Name  string `check:"required"`
+//		Email string `check:"required,email"`
+//		Age   int    `check:"gte=18"`
+//		Role  string `check:"in=admin|user"`
+//	}
+//
+// The `match` rule must be the last rule of a tag, as its parameter consists
+// of the rest of the tag (see ParseTag).
+//
+// Nested and embedded structs are validated recursively, along with struct
+// values referenced by pointers, slices, arrays and maps. Values referenced
+// by the same pointer are validated once, so values containing cycles (e.g.
+// linked lists) can be validated. Fields tagged with `check:"-"` are skipped,
+// along with unexported fields. The exported fields of embedded structs of
+// unexported types are validated, but the rules declared on the embedded
+// fields themselves are not applied, as their values cannot be accessed.
+// Rules other than `required` are not applied to nil pointers, and string
+// format rules (e.g. `email`) accept empty values, unless the field is also
+// marked as `required`.
+// The messages of the errors can be replaced using the `checkmsg` struct tag,
+// which maps rule names to messages, separated by semicolons:
+//
+//	Name string `check:"required,min_len=2" checkmsg:"required=Please enter your name"`
+//
+// Returns the first error it encounters, with the Field of the error set to
+// the path of the invalid field (e.g. `Address.Street`, `Items[2].Name`).
+func Struct(v interface{}) error {
+	return (&StructValidator{}).Validate(v)
+}
+
+// StructValidator validates structs based on the rules declared in their
+// `check` struct tags (see Struct).
+type StructValidator struct {
+	// Messages replaces the messages of the errors returned for the fields
+	// of the validated struct. The keys consist of the path of a field,
+	// without slice, array or map indexes, followed by a dot and the name
+	// of a rule (e.g. `Contacts.Email.required`). Paths use the field names
+	// selected by NameTag. The messages of keys which only contain the path
+	// of a field apply to all of its rules. Messages take precedence over
+	// the `checkmsg` struct tags.
+	Messages map[string]string
+
+	// NameTag is the struct tag from which the names of the fields used in
+	// error paths are read (e.g. `json`, `form` or `query`), so that errors
+	// reference the names sent by clients. Fields without a name in the tag
+	// use their Go names. If empty, Go names are used for all fields.
+	NameTag string
+
+	// All makes Validate return all the errors it encounters, as Errors,
+	// instead of only the first one. Only the first error of each field
+	// is returned.
+	All bool
+}
+
+// Validate validates the fields of the struct v (or pointer to struct).
+// Returns the first error it encounters, unless All is set.
+func (sv *StructValidator) Validate(v interface{}) error {
+	visited := map[visitKey]bool{}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return newError(CodeInvalid, v, nil, "cannot validate nil struct")
+		}
+		if rv.Kind() == reflect.Ptr {
+			visited[visitKey{rv.Pointer(), rv.Type()}] = true
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return newError(CodeInvalid, v, nil, "cannot validate nil struct")
+	}
+	if rv.Kind() != reflect.Struct {
+		return newError(CodeInvalid, v, nil, "cannot validate `%v` as struct", rv.Type())
+	}
+
+	if !sv.All {
+		return sv.validateStruct(rv, "", nil, visited)
+	}
+
+	var errs Errors
+	sv.validateStruct(rv, "", &errs, visited)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// fail records err, if errs is not nil, in which case the validation
+// continues. Otherwise, err is returned, in order to stop the validation.
+func (sv *StructValidator) fail(errs *Errors, err error) error {
+	if errs == nil {
+		return err
+	}
+
+	*errs = append(*errs, err)
+	return nil
+}
+
+func (sv *StructValidator) validateStruct(rv reflect.Value, prefix string, errs *Errors, visited map[visitKey]bool) error {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		tag, ok := sf.Tag.Lookup("check")
+		if tag == "-" {
+			continue
+		}
+
+		path := prefix
+		if !sf.Anonymous {
+			path = joinPath(prefix, sv.fieldName(sf))
+		}
+
+		fv := rv.Field(i)
+		if ok && fv.CanInterface() {
+			if err := sv.validateField(fv, path, tag, sf.Tag.Get("checkmsg")); err != nil {
+				if err = sv.fail(errs, err); err != nil {
+					return err
+				}
+			}
+		}
+		if err := sv.validateValue(fv, path, errs, visited); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sv *StructValidator) validateField(fv reflect.Value, path, tag, msgTag string) error {
+	rules, err := ParseTag(tag)
+	if err != nil {
+		return withField(err, path)
+	}
+
+	for _, rule := range rules {
+		fn, ok := lookupRule(rule.Name)
+		if !ok {
+			return withField(newError(CodeInvalid, nil, nil, "unknown rule `%s`", rule.Name), path)
+		}
+
+		v := fv
+		if rule.Name != "required" {
+			for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+				if v.IsNil() {
+					break
+				}
+				v = v.Elem()
+			}
+			if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+				continue
+			}
+		}
+
+		x := v.Interface()
+		if rule.Name != "required" {
+			// Validate the values of driver.Valuer implementations, such as
+			// sql.NullString, and skip the NULL ones.
+			var null bool
+			if x, null, err = valuerValue(x); err != nil {
+				return withField(err, path)
+			} else if null {
+				continue
+			}
+		}
+
+		vf, err := fn(x, rule.Param)
+		if err != nil {
+			return withField(err, path)
+		}
+		if err = vf(); err != nil {
+			if msg, ok := sv.message(path, rule.Name, msgTag); ok {
+				err = withMessage(err, msg)
+			}
+			return withField(err, path)
+		}
+	}
+
+	return nil
+}
+
+func (sv *StructValidator) fieldName(sf reflect.StructField) string {
+	if sv.NameTag == "" {
+		return sf.Name
+	}
+
+	name, _, _ := strings.Cut(sf.Tag.Get(sv.NameTag), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+
+	return name
+}
+
+func (sv *StructValidator) message(path, rule, msgTag string) (string, bool) {
+	key := regPathIndex.ReplaceAllString(path, "")
+	if msg, ok := sv.Messages[key+"."+rule]; ok {
+		return msg, true
+	}
+	if msg, ok := sv.Messages[key]; ok {
+		return msg, true
+	}
+
+	for _, part := range strings.Split(msgTag, ";") {
+		name, msg, ok := strings.Cut(part, "=")
+		if ok && strings.TrimSpace(name) == rule {
+			return msg, true
+		}
+	}
+
+	return "", false
+}
+
+// visitKey identifies a value referenced by a pointer. The type is part of
+// the key, as pointers of different types can share the same address (e.g.
+// a pointer to a struct and a pointer to its first field).
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// validateValue validates the structs contained by v. Pointers which were
+// already visited are skipped, in order to avoid infinite recursion on
+// values containing cycles.
+func (sv *StructValidator) validateValue(v reflect.Value, path string, errs *Errors, visited map[visitKey]bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		key := visitKey{v.Pointer(), v.Type()}
+		if v.IsNil() || visited[key] {
+			return nil
+		}
+		visited[key] = true
+		return sv.validateValue(v.Elem(), path, errs, visited)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return sv.validateValue(v.Elem(), path, errs, visited)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return nil
+		}
+		return sv.validateStruct(v, path, errs, visited)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := sv.validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs, visited); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			if err := sv.validateValue(v.MapIndex(key), fmt.Sprintf("%s[%v]", path, key), errs, visited); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	if name == "" || name[0] == '[' {
+		return prefix + name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,127 @@
+package check
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TraceEntry contains the outcome of a validation function executed by
+// RunInstrumented.
+type TraceEntry struct {
+	// Name is the name of the validator which created the validation
+	// function (e.g. `check.Email`), derived from the function itself.
+	Name string `json:"name"`
+
+	// Field is the field of the returned error, if any.
+	Field string `json:"field,omitempty"`
+
+	// Err is the error returned by the validation function, if any.
+	Err error `json:"-"`
+
+	// Duration is the execution time of the validation function.
+	Duration time.Duration `json:"duration"`
+}
+
+// Trace contains the outcomes of the validation functions executed by
+// RunInstrumented, in execution order.
+type Trace struct {
+	Entries  []TraceEntry  `json:"entries"`
+	Duration time.Duration `json:"duration"`
+}
+
+// RunInstrumented executes all the validation functions, unlike Run, and
+// returns a trace containing the name, outcome and execution time of each
+// of them, in order to identify the validators which dominate latency.
+func RunInstrumented(vfs ...ValidateFunc) *Trace {
+	t := &Trace{Entries: make([]TraceEntry, 0, len(vfs))}
+	for _, vf := range vfs {
+		start := time.Now()
+		err := vf()
+		entry := TraceEntry{
+			Name:     funcName(vf),
+			Err:      err,
+			Duration: time.Since(start),
+		}
+		notifyObservers(loadObservers(), entry.Name, err, entry.Duration)
+		if e, ok := err.(*Error); ok {
+			entry.Field = e.Field
+		}
+
+		t.Entries = append(t.Entries, entry)
+		t.Duration += entry.Duration
+	}
+
+	return t
+}
+
+// Err returns the first error encountered by the traced run, if any.
+func (t *Trace) Err() error {
+	for _, entry := range t.Entries {
+		if entry.Err != nil {
+			return entry.Err
+		}
+	}
+
+	return nil
+}
+
+// Slowest returns the n entries with the longest execution times, in
+// descending order of their duration. Returns no entries if n is not
+// positive.
+func (t *Trace) Slowest(n int) []TraceEntry {
+	if n < 0 {
+		n = 0
+	}
+
+	entries := append([]TraceEntry(nil), t.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Duration > entries[j].Duration
+	})
+	if n < len(entries) {
+		entries = entries[:n]
+	}
+
+	return entries
+}
+
+// funcName returns the name of the function which created the validation
+// function vf (e.g. `check.Email` for the functions returned by Email).
+// The vf parameter must be a ValidateFunc or a ValidateCtxFunc.
+func funcName(vf interface{}) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(vf).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	// Remove the type parameters of generic functions and the suffixes of
+	// closures (e.g. `.func1`, `.func1.2`).
+	name = strings.ReplaceAll(name, "[...]", "")
+	parts := strings.Split(name, ".")
+	for len(parts) > 2 && isClosureSuffix(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func isClosureSuffix(s string) bool {
+	s = strings.TrimPrefix(s, "func")
+	if s == "" {
+		return true
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
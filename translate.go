@@ -0,0 +1,179 @@
+package check
+
+import (
+	"sync/atomic"
+
+	"golang.org/x/text/language"
+)
+
+// Translator returns the message of an error with the specified code and
+// parameters. Besides the parameters of the check, the params map contains
+// the validated value, under the `value` key. Translators return an empty
+// string for errors they cannot translate, in which case the default
+// message is used.
+type Translator func(code string, params map[string]interface{}) string
+
+var globalTranslator atomic.Pointer[Translator]
+
+// SetTranslator sets the translator used to generate the messages of the
+// errors returned by all validation functions. Messages replaced using
+// WithMessage, WithMessagef or the `checkmsg` struct tag take precedence
+// over the translated messages. If t is nil, the default messages are used.
+func SetTranslator(t Translator) {
+	if t == nil {
+		globalTranslator.Store(nil)
+		return
+	}
+
+	globalTranslator.Store(&t)
+}
+
+func translate(code string, value interface{}, params map[string]interface{}) (string, bool) {
+	t := globalTranslator.Load()
+	if t == nil {
+		return "", false
+	}
+
+	tparams := make(map[string]interface{}, len(params)+1)
+	for name, param := range params {
+		tparams[name] = param
+	}
+	tparams["value"] = value
+
+	msg := (*t)(code, tparams)
+	return msg, msg != ""
+}
+
+// Translator returns a translator which generates messages using the
+// templates registered in the catalog for the specified locale. The
+// `{field}` placeholder is not available to translators, as the name
+// of the field is set after the message is generated. If the locale
+// is not valid, the returned translator does not translate any message.
+func (c *Catalog) Translator(locale string) Translator {
+	tag, err := parseLocale(locale)
+	return func(code string, params map[string]interface{}) string {
+		if err != nil {
+			return ""
+		}
+
+		msg, ok := c.lookup(tag, code)
+		if !ok {
+			return ""
+		}
+
+		return c.render(tag, msg, &Error{Code: code, Value: params["value"], Params: params})
+	}
+}
+
+// Locale returns a translator which generates messages using the built-in
+// message bundle of the specified locale (e.g. `de`, `fr-CA`). Bundles are
+// available for German, French and Spanish, and cover the most common error
+// codes. Errors with other codes use the default messages.
+//
+//	check.SetTranslator(check.Locale("de"))
+func Locale(locale string) Translator {
+	return bundles.Translator(locale)
+}
+
+// bundles contains the built-in message bundles, indexed by locale.
+var bundles = &Catalog{messages: map[language.Tag]map[string]Message{
+	language.German: {
+		CodeRequired:     {Other: "darf nicht leer sein"},
+		CodeEq:           {Other: "muss gleich {term} sein"},
+		CodeNe:           {Other: "darf nicht gleich {term} sein"},
+		CodeLt:           {Other: "muss kleiner als {term} sein"},
+		CodeLte:          {Other: "muss kleiner als oder gleich {term} sein"},
+		CodeGt:           {Other: "muss größer als {term} sein"},
+		CodeGte:          {Other: "muss größer als oder gleich {term} sein"},
+		CodeIn:           {Other: "muss einer der Werte {elems} sein"},
+		CodeNotIn:        {Other: "darf keiner der Werte {elems} sein"},
+		CodeEnum:         {Other: "muss einer der folgenden Werte sein: {list}"},
+		CodeMatch:        {Other: "hat ein ungültiges Format"},
+		CodeEmail:        {Other: "ist keine gültige E-Mail-Adresse"},
+		CodeURL:          {Other: "ist keine gültige URL"},
+		CodeUUID:         {Other: "ist keine gültige UUID"},
+		CodeIP:           {Other: "ist keine gültige IP-Adresse"},
+		CodePhone:        {Other: "ist keine gültige Telefonnummer"},
+		CodeDateTime:     {Other: "ist kein gültiges Datum"},
+		CodeAlpha:        {Other: "darf nur Buchstaben enthalten"},
+		CodeAlphanumeric: {Other: "darf nur Buchstaben und Ziffern enthalten"},
+		CodeNumeric:      {Other: "darf nur Ziffern enthalten"},
+		CodeLen:          {Other: "muss genau {len} Zeichen lang sein"},
+		CodeMinLen:       {Other: "muss mindestens {min} Zeichen lang sein"},
+		CodeMaxLen:       {Other: "darf höchstens {max} Zeichen lang sein"},
+	},
+	language.French: {
+		CodeRequired:     {Other: "ne peut pas être vide"},
+		CodeEq:           {Other: "doit être égal à {term}"},
+		CodeNe:           {Other: "ne doit pas être égal à {term}"},
+		CodeLt:           {Other: "doit être inférieur à {term}"},
+		CodeLte:          {Other: "doit être inférieur ou égal à {term}"},
+		CodeGt:           {Other: "doit être supérieur à {term}"},
+		CodeGte:          {Other: "doit être supérieur ou égal à {term}"},
+		CodeIn:           {Other: "doit être l'une des valeurs {elems}"},
+		CodeNotIn:        {Other: "ne doit être aucune des valeurs {elems}"},
+		CodeEnum:         {Other: "doit être l'une des valeurs suivantes : {list}"},
+		CodeMatch:        {Other: "a un format invalide"},
+		CodeEmail:        {Other: "n'est pas une adresse e-mail valide"},
+		CodeURL:          {Other: "n'est pas une URL valide"},
+		CodeUUID:         {Other: "n'est pas un UUID valide"},
+		CodeIP:           {Other: "n'est pas une adresse IP valide"},
+		CodePhone:        {Other: "n'est pas un numéro de téléphone valide"},
+		CodeDateTime:     {Other: "n'est pas une date valide"},
+		CodeAlpha:        {Other: "ne doit contenir que des lettres"},
+		CodeAlphanumeric: {Other: "ne doit contenir que des lettres et des chiffres"},
+		CodeNumeric:      {Other: "ne doit contenir que des chiffres"},
+		CodeLen: {
+			Plural: "len",
+			One:    "doit contenir exactement {len} caractère",
+			Other:  "doit contenir exactement {len} caractères",
+		},
+		CodeMinLen: {
+			Plural: "min",
+			One:    "doit contenir au moins {min} caractère",
+			Other:  "doit contenir au moins {min} caractères",
+		},
+		CodeMaxLen: {
+			Plural: "max",
+			One:    "doit contenir au plus {max} caractère",
+			Other:  "doit contenir au plus {max} caractères",
+		},
+	},
+	language.Spanish: {
+		CodeRequired:     {Other: "no puede estar vacío"},
+		CodeEq:           {Other: "debe ser igual a {term}"},
+		CodeNe:           {Other: "no debe ser igual a {term}"},
+		CodeLt:           {Other: "debe ser menor que {term}"},
+		CodeLte:          {Other: "debe ser menor o igual que {term}"},
+		CodeGt:           {Other: "debe ser mayor que {term}"},
+		CodeGte:          {Other: "debe ser mayor o igual que {term}"},
+		CodeIn:           {Other: "debe ser uno de los valores {elems}"},
+		CodeNotIn:        {Other: "no debe ser ninguno de los valores {elems}"},
+		CodeEnum:         {Other: "debe ser uno de los siguientes valores: {list}"},
+		CodeMatch:        {Other: "tiene un formato no válido"},
+		CodeEmail:        {Other: "no es una dirección de correo electrónico válida"},
+		CodeURL:          {Other: "no es una URL válida"},
+		CodeUUID:         {Other: "no es un UUID válido"},
+		CodeIP:           {Other: "no es una dirección IP válida"},
+		CodePhone:        {Other: "no es un número de teléfono válido"},
+		CodeDateTime:     {Other: "no es una fecha válida"},
+		CodeAlpha:        {Other: "solo puede contener letras"},
+		CodeAlphanumeric: {Other: "solo puede contener letras y números"},
+		CodeNumeric:      {Other: "solo puede contener números"},
+		CodeLen: {
+			Plural: "len",
+			One:    "debe tener exactamente {len} carácter",
+			Other:  "debe tener exactamente {len} caracteres",
+		},
+		CodeMinLen: {
+			Plural: "min",
+			One:    "debe tener al menos {min} carácter",
+			Other:  "debe tener al menos {min} caracteres",
+		},
+		CodeMaxLen: {
+			Plural: "max",
+			One:    "debe tener como máximo {max} carácter",
+			Other:  "debe tener como máximo {max} caracteres",
+		},
+	},
+}}
@@ -1,7 +1,6 @@
 package check
 
 import (
-	"errors"
 	"strings"
 	"unicode"
 )
@@ -11,10 +10,10 @@ func requiredErr(required bool, message string) error {
 		return nil
 	}
 	if message = strings.TrimSpace(message); message != "" {
-		return errors.New(message)
+		return newError(CodeRequired, nil, nil, "%s", message)
 	}
 
-	return errEmpty
+	return newError(CodeRequired, nil, nil, "%s", errEmpty)
 }
 
 func stripSpaces(s string) string {
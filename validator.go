@@ -0,0 +1,101 @@
+package check
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ValueFunc creates a validation function for the value x.
+type ValueFunc func(x interface{}) ValidateFunc
+
+// Rules returns a ValueFunc which validates values using the specified
+// rules, in the format of the `check` struct tags (e.g. `required,email`).
+// Rules other than `required` are not applied to nil values.
+func Rules(tag string) ValueFunc {
+	rules, err := ParseTag(tag)
+	return rulesFunc(rules, err)
+}
+
+// rulesFunc returns a ValueFunc which validates values using the specified
+// rules or, if err is not nil, returns err.
+func rulesFunc(rules []Rule, err error) ValueFunc {
+	return func(x interface{}) ValidateFunc {
+		return func() error {
+			if err != nil {
+				return err
+			}
+			if x == nil {
+				for _, rule := range rules {
+					if rule.Name == "required" {
+						return Required(x)()
+					}
+				}
+				return nil
+			}
+
+			return checkRules(reflect.ValueOf(x), rules)
+		}
+	}
+}
+
+// Validator validates maps of values (e.g. decoded request bodies) against
+// named sets of rules. The rules are registered once, after which the
+// validator can be used repeatedly. It is safe for concurrent use.
+type Validator struct {
+	mu     sync.RWMutex
+	fields []string
+	rules  map[string][]ValueFunc
+}
+
+// NewValidator returns a new validator without rules.
+func NewValidator() *Validator {
+	return &Validator{rules: map[string][]ValueFunc{}}
+}
+
+// Rule adds rules for the field with the specified name. The rules of
+// a field are executed in the order in which they are added and fields
+// are validated in the order in which their first rule is added.
+func (v *Validator) Rule(field string, fns ...ValueFunc) *Validator {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if _, ok := v.rules[field]; !ok {
+		v.fields = append(v.fields, field)
+	}
+	v.rules[field] = append(v.rules[field], fns...)
+
+	return v
+}
+
+// Validate validates the values of the data map against the rules of their
+// fields. Fields missing from the map are validated as nil values. Returns
+// the first error it encounters, with the Field of the error set to the
+// name of the invalid field.
+func (v *Validator) Validate(data map[string]interface{}) error {
+	return Run(v.funcs(data)...)
+}
+
+// Report validates the values of the data map against the rules of their
+// fields, like Validate, and returns a result containing all the errors
+// (see Report). Only the first error of each field is reported.
+func (v *Validator) Report(data map[string]interface{}) *Result {
+	return Report(v.funcs(data)...)
+}
+
+func (v *Validator) funcs(data map[string]interface{}) []ValidateFunc {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	vfs := make([]ValidateFunc, 0, len(v.fields))
+	for _, field := range v.fields {
+		x := data[field]
+
+		fieldVfs := make([]ValidateFunc, len(v.rules[field]))
+		for i, fn := range v.rules[field] {
+			fieldVfs[i] = fn(x)
+		}
+		vfs = append(vfs, Field(field, fieldVfs...))
+	}
+
+	return vfs
+}
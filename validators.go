@@ -2,10 +2,8 @@ package check
 
 import (
 	"errors"
-	"fmt"
 	"net"
 	"net/mail"
-	"regexp"
 	"strings"
 )
 
@@ -23,7 +21,7 @@ func Required(args ...interface{}) ValidateFunc {
 	return func() error {
 		for _, arg := range args {
 			if isEmpty(arg) {
-				return errEmpty
+				return newError(CodeRequired, arg, nil, "%s", errEmpty)
 			}
 		}
 
@@ -141,7 +139,8 @@ func In(x interface{}, elems ...interface{}) ValidateFunc {
 			}
 		}
 
-		return fmt.Errorf("`in` comparison failed: `%v` not in `%v`", x, elems)
+		return newError(CodeIn, x, map[string]interface{}{"elems": elems},
+			"`in` comparison failed: `%v` not in `%v`", x, elems)
 	}
 }
 
@@ -154,7 +153,8 @@ func NotIn(x interface{}, elems ...interface{}) ValidateFunc {
 				return err
 			}
 			if err = compare(x, cmpField); err == nil {
-				return fmt.Errorf("`not in` comparison failed: `%v` in `%v`", x, elems)
+				return newError(CodeNotIn, x, map[string]interface{}{"elems": elems},
+					"`not in` comparison failed: `%v` in `%v`", x, elems)
 			}
 		}
 
@@ -163,22 +163,22 @@ func NotIn(x interface{}, elems ...interface{}) ValidateFunc {
 }
 
 // Matches checks if the val parameter matches the pattern (regular expression).
-// The value can be empty if the required parameter is false.
+// The compiled patterns are cached, so repeated calls with the same pattern
+// do not recompile it. The value can be empty if the required parameter
+// is false.
 func Matches(val, pattern string, required bool) ValidateFunc {
 	return func() error {
 		if isEmptyStr(val) {
 			return requiredErr(required, "match term cannot be empty")
 		}
 
-		ok, err := regexp.MatchString(pattern, val)
+		re, err := compilePattern(pattern)
 		if err != nil {
-			return fmt.Errorf("invalid pattern `%s`", pattern)
-		}
-		if !ok {
-			return fmt.Errorf("`%s` does not match pattern `%s`", val, pattern)
+			return newError(CodeInvalid, val, map[string]interface{}{"pattern": pattern},
+				"invalid pattern `%s`", pattern)
 		}
 
-		return nil
+		return MatchesRegexp(val, re, required)()
 	}
 }
 
@@ -191,7 +191,7 @@ func Email(email string, required bool) ValidateFunc {
 		}
 
 		if _, err := mail.ParseAddress(email); err != nil {
-			return fmt.Errorf("invalid email address `%s`", email)
+			return newError(CodeEmail, email, nil, "invalid email address `%s`", email)
 		}
 
 		return nil
@@ -209,7 +209,7 @@ func EmailList(list string, required bool) ValidateFunc {
 		emails := strings.Split(list, ",")
 		for _, email := range emails {
 			if _, err := mail.ParseAddress(email); err != nil {
-				return fmt.Errorf("invalid email address `%s`", email)
+				return newError(CodeEmail, email, nil, "invalid email address `%s`", email)
 			}
 		}
 
@@ -217,45 +217,18 @@ func EmailList(list string, required bool) ValidateFunc {
 	}
 }
 
-// URL checks if the url parameter is a valid URL.
+// URL checks if the url parameter is a valid http, https or ftp URL. The
+// scheme is optional (e.g. `example.com/path`). The host must be an IPv4
+// address or a host name ending with a top-level domain. See URLWith for
+// validating other kinds of URLs.
 // The URL can be empty if the required parameter is false.
 func URL(url string, required bool) ValidateFunc {
 	return func() error {
 		if isEmptyStr(url) {
 			return requiredErr(required, "URL cannot be empty")
 		}
-		if ok := regURL.MatchString(url); !ok {
-			return fmt.Errorf("invalid URL `%s`", url)
-		}
-
-		return nil
-	}
-}
-
-// IBAN checks if the iban parameter is a valid IBAN.
-// The IBAN can be empty if the required parameter is false.
-func IBAN(iban string, required bool) ValidateFunc {
-	return func() error {
-		if isEmptyStr(iban) {
-			return requiredErr(required, "IBAN cannot be empty")
-		}
-		if ok := regIBAN.MatchString(iban); !ok {
-			return fmt.Errorf("invalid IBAN `%s`", iban)
-		}
-
-		return nil
-	}
-}
-
-// VAT checks if the vat parameter is a valid VAT number.
-// The VAT number can be empty if the required parameter is false.
-func VAT(vat string, required bool) ValidateFunc {
-	return func() error {
-		if isEmptyStr(vat) {
-			return requiredErr(required, "VAT number cannot be empty")
-		}
-		if ok := regVAT.MatchString(vat); !ok {
-			return fmt.Errorf("invalid VAT number `%s`", vat)
+		if !isWebURL(url) {
+			return newError(CodeURL, url, nil, "invalid URL `%s`", url)
 		}
 
 		return nil
@@ -270,7 +243,7 @@ func IP(ip string, required bool) ValidateFunc {
 			return requiredErr(required, "IP address cannot be empty")
 		}
 		if addr := net.ParseIP(ip); addr == nil {
-			return fmt.Errorf("invalid IP address `%s`", ip)
+			return newError(CodeIP, ip, nil, "invalid IP address `%s`", ip)
 		}
 
 		return nil
@@ -285,7 +258,7 @@ func MAC(mac string, required bool) ValidateFunc {
 			return requiredErr(required, "MAC address cannot be empty")
 		}
 		if _, err := net.ParseMAC(mac); err != nil {
-			return fmt.Errorf("invalid mac address `%s`", mac)
+			return newError(CodeMAC, mac, nil, "invalid mac address `%s`", mac)
 		}
 
 		return nil
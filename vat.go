@@ -0,0 +1,304 @@
+package check
+
+import (
+	"strconv"
+	"strings"
+)
+
+type vatFormat struct {
+	pattern  *lazyRegexp
+	checksum func(number string) bool
+}
+
+// Formats and check digit algorithms of the VAT numbers of the supported
+// countries. The patterns match the numbers without the country prefix.
+// Countries without a checksum function are only checked against the format.
+var vatFormats = map[string]vatFormat{
+	"AT": {newLazyRegexp(`^U[0-9]{8}$`), vatChecksumAT},
+	"BE": {newLazyRegexp(`^[01][0-9]{9}$`), vatChecksumBE},
+	"BG": {newLazyRegexp(`^[0-9]{9,10}$`), nil},
+	"CY": {newLazyRegexp(`^[0-9]{8}[A-Z]$`), nil},
+	"CZ": {newLazyRegexp(`^[0-9]{8,10}$`), nil},
+	"DE": {newLazyRegexp(`^[1-9][0-9]{8}$`), vatChecksumDE},
+	"DK": {newLazyRegexp(`^[1-9][0-9]{7}$`), vatChecksumDK},
+	"EE": {newLazyRegexp(`^10[0-9]{7}$`), nil},
+	"EL": {newLazyRegexp(`^[0-9]{9}$`), vatChecksumEL},
+	"ES": {newLazyRegexp(`^[0-9A-Z][0-9]{7}[0-9A-Z]$`), vatChecksumES},
+	"FI": {newLazyRegexp(`^[0-9]{8}$`), vatChecksumFI},
+	"FR": {newLazyRegexp(`^[0-9A-HJ-NP-Z]{2}[0-9]{9}$`), vatChecksumFR},
+	"GB": {newLazyRegexp(`^([0-9]{9}([0-9]{3})?|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`), vatChecksumGB},
+	"HR": {newLazyRegexp(`^[0-9]{11}$`), nil},
+	"HU": {newLazyRegexp(`^[0-9]{8}$`), nil},
+	"IE": {newLazyRegexp(`^[0-9][0-9A-Z+*][0-9]{5}[A-W][A-I]?$`), nil},
+	"IT": {newLazyRegexp(`^[0-9]{11}$`), luhn},
+	"LT": {newLazyRegexp(`^([0-9]{9}|[0-9]{12})$`), nil},
+	"LU": {newLazyRegexp(`^[0-9]{8}$`), vatChecksumLU},
+	"LV": {newLazyRegexp(`^[0-9]{11}$`), nil},
+	"MT": {newLazyRegexp(`^[1-9][0-9]{7}$`), nil},
+	"NL": {newLazyRegexp(`^[0-9]{9}B[0-9]{2}$`), vatChecksumNL},
+	"PL": {newLazyRegexp(`^[0-9]{10}$`), vatChecksumPL},
+	"PT": {newLazyRegexp(`^[1-9][0-9]{8}$`), vatChecksumPT},
+	"RO": {newLazyRegexp(`^[1-9][0-9]{1,9}$`), nil},
+	"SE": {newLazyRegexp(`^[0-9]{10}01$`), vatChecksumSE},
+	"SI": {newLazyRegexp(`^[1-9][0-9]{7}$`), vatChecksumSI},
+	"SK": {newLazyRegexp(`^[1-9][0-9]{9}$`), nil},
+}
+
+// VAT checks if the vat parameter is a valid VAT number. If the VAT number
+// starts with the prefix of a supported country, its format and check digits
+// are verified using the rules of the country. Otherwise, the number must
+// match the format of any of the supported countries. Whitespace is ignored.
+// The VAT number can be empty if the required parameter is false.
+func VAT(vat string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(vat) {
+			return requiredErr(required, "VAT number cannot be empty")
+		}
+
+		normalized := strings.ToUpper(stripSpaces(vat))
+		if len(normalized) > 2 {
+			country := vatCountry(normalized[:2])
+			if format, ok := vatFormats[country]; ok {
+				if !format.valid(normalized[2:]) {
+					return newError(CodeVAT, vat, map[string]interface{}{"country": country},
+						"invalid VAT number `%s`", vat)
+				}
+				return nil
+			}
+		}
+		if ok := regVAT.MatchString(normalized); !ok {
+			return newError(CodeVAT, vat, nil, "invalid VAT number `%s`", vat)
+		}
+
+		return nil
+	}
+}
+
+// VATCountry checks if the vat parameter is a valid VAT number of the
+// specified country (ISO 3166-1 alpha-2 country code). The country prefix
+// of the VAT number is optional but, if present, it must match the country.
+// The format and check digits are verified using the rules of the country.
+// Whitespace is ignored. The VAT number can be empty if the required
+// parameter is false.
+func VATCountry(vat, country string, required bool) ValidateFunc {
+	return func() error {
+		if isEmptyStr(vat) {
+			return requiredErr(required, "VAT number cannot be empty")
+		}
+
+		code := vatCountry(strings.ToUpper(country))
+		format, ok := vatFormats[code]
+		if !ok {
+			return newError(CodeInvalid, vat, map[string]interface{}{"country": country},
+				"unsupported VAT number country `%s`", country)
+		}
+
+		normalized := strings.ToUpper(stripSpaces(vat))
+		if len(normalized) > 2 && vatCountry(normalized[:2]) == code {
+			normalized = normalized[2:]
+		}
+		if !format.valid(normalized) {
+			return newError(CodeVAT, vat, map[string]interface{}{"country": country},
+				"invalid VAT number `%s` for country `%s`", vat, country)
+		}
+
+		return nil
+	}
+}
+
+// vatCountry returns the VAT prefix of the specified country code.
+// Greece uses the EL prefix instead of its ISO 3166-1 code.
+func vatCountry(code string) string {
+	if code == "GR" {
+		return "EL"
+	}
+
+	return code
+}
+
+func (f vatFormat) valid(number string) bool {
+	if !f.pattern.MatchString(number) {
+		return false
+	}
+
+	return f.checksum == nil || f.checksum(number)
+}
+
+func vatDigits(number string) []int {
+	digits := make([]int, 0, len(number))
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+
+	return digits
+}
+
+func vatWeightedSum(digits []int, weights ...int) int {
+	var sum int
+	for i, w := range weights {
+		sum += digits[i] * w
+	}
+
+	return sum
+}
+
+func vatChecksumAT(number string) bool {
+	d := vatDigits(number)
+
+	sum := d[0] + d[2] + d[4] + d[6]
+	for _, i := range []int{1, 3, 5} {
+		sum += d[i]*2/10 + d[i]*2%10
+	}
+
+	return (96-sum)%10 == d[7]
+}
+
+func vatChecksumBE(number string) bool {
+	base, _ := strconv.Atoi(number[:8])
+	check, _ := strconv.Atoi(number[8:])
+
+	return 97-base%97 == check
+}
+
+// vatChecksumDE verifies the check digit using the ISO 7064 mod 11,10
+// algorithm.
+func vatChecksumDE(number string) bool {
+	d := vatDigits(number)
+
+	p := 10
+	for _, digit := range d[:8] {
+		s := (digit + p) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = 2 * s % 11
+	}
+
+	return (11-p)%10 == d[8]
+}
+
+func vatChecksumDK(number string) bool {
+	return vatWeightedSum(vatDigits(number), 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func vatChecksumEL(number string) bool {
+	d := vatDigits(number)
+	return vatWeightedSum(d, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == d[8]
+}
+
+func vatChecksumES(number string) bool {
+	const nifLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+	first, last := number[0], number[8]
+	switch {
+	case first >= '0' && first <= '9', first == 'X', first == 'Y', first == 'Z':
+		// Individuals (NIF) and foreigners (NIE). The first letter of the
+		// NIE numbers is replaced by a digit (X = 0, Y = 1, Z = 2).
+		digits := number[:8]
+		if i := strings.IndexByte("XYZ", first); i >= 0 {
+			digits = strconv.Itoa(i) + number[1:8]
+		}
+
+		n, _ := strconv.Atoi(digits)
+		return last == nifLetters[n%23]
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", first) >= 0:
+		// Legal entities (CIF).
+		d := vatDigits(number[1:8])
+
+		sum := d[1] + d[3] + d[5]
+		for _, i := range []int{0, 2, 4, 6} {
+			sum += d[i]*2/10 + d[i]*2%10
+		}
+
+		control := (10 - sum%10) % 10
+		return last == byte('0'+control) || last == "JABCDEFGHI"[control]
+	}
+
+	return false
+}
+
+func vatChecksumFI(number string) bool {
+	d := vatDigits(number)
+
+	r := vatWeightedSum(d, 7, 9, 10, 5, 8, 4, 2) % 11
+	switch r {
+	case 0:
+		return d[7] == 0
+	case 1:
+		return false
+	}
+
+	return 11-r == d[7]
+}
+
+// vatChecksumFR verifies the numeric validation key of the VAT number.
+// Alphanumeric keys are only checked against the format.
+func vatChecksumFR(number string) bool {
+	key, err := strconv.Atoi(number[:2])
+	if err != nil {
+		return true
+	}
+	siren, _ := strconv.Atoi(number[2:])
+
+	return key == (12+3*(siren%97))%97
+}
+
+func vatChecksumGB(number string) bool {
+	if strings.HasPrefix(number, "GD") || strings.HasPrefix(number, "HA") {
+		return true
+	}
+
+	d := vatDigits(number)
+	total := vatWeightedSum(d, 8, 7, 6, 5, 4, 3, 2) + d[7]*10 + d[8]
+
+	return total%97 == 0 || (total+55)%97 == 0
+}
+
+func vatChecksumLU(number string) bool {
+	base, _ := strconv.Atoi(number[:6])
+	check, _ := strconv.Atoi(number[6:])
+
+	return base%89 == check
+}
+
+// vatChecksumNL verifies the check digit of the VAT numbers of legal
+// entities (mod 11) or, alternatively, of sole proprietors (mod 97).
+func vatChecksumNL(number string) bool {
+	d := vatDigits(number)
+	if r := vatWeightedSum(d, 9, 8, 7, 6, 5, 4, 3, 2) % 11; r != 10 && r == d[8] {
+		return true
+	}
+
+	rem, ok := mod97("NL" + number)
+	return ok && rem == 1
+}
+
+func vatChecksumPL(number string) bool {
+	d := vatDigits(number)
+	return vatWeightedSum(d, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == d[9]
+}
+
+func vatChecksumPT(number string) bool {
+	d := vatDigits(number)
+	return (11-vatWeightedSum(d, 9, 8, 7, 6, 5, 4, 3, 2)%11)%11%10 == d[8]
+}
+
+func vatChecksumSE(number string) bool {
+	return luhn(number[:10])
+}
+
+func vatChecksumSI(number string) bool {
+	d := vatDigits(number)
+
+	r := 11 - vatWeightedSum(d, 8, 7, 6, 5, 4, 3, 2)%11
+	switch r {
+	case 10:
+		return d[7] == 0
+	case 11:
+		return false
+	}
+
+	return r == d[7]
+}
@@ -0,0 +1,130 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultVIESURL = "https://ec.europa.eu/taxation_customs/vies/rest-api"
+
+// VIES verifies that VAT numbers are registered using the VAT Information
+// Exchange System (VIES) of the European Commission.
+type VIES struct {
+	// Client is the HTTP client used to query the service.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// URL is the base URL of the VIES REST API.
+	// If empty, the public endpoint of the European Commission is used.
+	URL string
+
+	// Timeout limits the duration of each query. A value of 0 means that
+	// only the deadline of the context applies.
+	Timeout time.Duration
+
+	// Cache stores the results of the queries for the duration of CacheTTL.
+	// If nil, the results are not cached.
+	Cache    Cache
+	CacheTTL time.Duration
+}
+
+var defaultVIES = &VIES{}
+
+// VATExists checks if the vat parameter is a valid VAT number which is
+// registered in the VIES database, using the default VIES configuration.
+// The VAT number can be empty if the required parameter is false.
+func VATExists(vat string, required bool) ValidateCtxFunc {
+	return defaultVIES.VATExists(vat, required)
+}
+
+// VATExists checks if the vat parameter is a valid VAT number which is
+// registered in the VIES database. The format and check digits of the VAT
+// number are verified before querying the service. Failures of the service
+// are returned as errors which are not of type *Error, so that the function
+// can be wrapped by a circuit breaker. The VAT number can be empty if the
+// required parameter is false.
+func (v *VIES) VATExists(vat string, required bool) ValidateCtxFunc {
+	return func(ctx context.Context) error {
+		if err := VAT(vat, required)(); err != nil || isEmptyStr(vat) {
+			return err
+		}
+
+		normalized := strings.ToUpper(stripSpaces(vat))
+		country, number := normalized[:2], normalized[2:]
+		if _, ok := vatFormats[vatCountry(country)]; !ok {
+			return newError(CodeVAT, vat, nil, "invalid VAT number `%s`", vat)
+		}
+
+		lookup := func(ctx context.Context) error {
+			exists, err := v.query(ctx, vatCountry(country), number)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return newError(CodeVATNotRegistered, vat, map[string]interface{}{"country": country},
+					"VAT number `%s` is not registered", vat)
+			}
+
+			return nil
+		}
+		if v.Cache != nil {
+			lookup = Cached(v.Cache, "vies", normalized, v.CacheTTL, lookup)
+		}
+
+		return lookup(ctx)
+	}
+}
+
+func (v *VIES) query(ctx context.Context, country, number string) (bool, error) {
+	if v.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.Timeout)
+		defer cancel()
+	}
+
+	baseURL, client := v.URL, v.Client
+	if baseURL == "" {
+		baseURL = defaultVIESURL
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	reqURL := strings.TrimSuffix(baseURL, "/") + "/ms/" + url.PathEscape(country) +
+		"/vat/" + url.PathEscape(number)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("VIES request failed with status %d", res.StatusCode)
+	}
+
+	var result struct {
+		IsValid   bool   `json:"isValid"`
+		UserError string `json:"userError"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("invalid VIES response: %w", err)
+	}
+
+	switch result.UserError {
+	case "", "VALID", "INVALID":
+		return result.IsValid, nil
+	}
+
+	return false, fmt.Errorf("VIES request failed: %s", result.UserError)
+}
@@ -0,0 +1,192 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// WireVersion is the version of the wire format of validation reports
+// produced by NewWireReport. The version is incremented for changes which
+// are not backwards compatible. Fields added in a compatible manner do not
+// change the version, so consumers should ignore unknown fields.
+const WireVersion = 1
+
+// Severity represents the severity of a reported violation.
+type Severity string
+
+// Violation severities.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// WireViolation is the wire representation of a single violation of
+// a validation report.
+type WireViolation struct {
+	Field    string                 `json:"field,omitempty"`
+	Code     string                 `json:"code"`
+	Message  string                 `json:"message"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Severity Severity               `json:"severity"`
+}
+
+// WireReport is a versioned validation report, meant to be exchanged with
+// services written in other languages. The JSON encoding of the report is
+// stable and can be decoded using the canonical JSON mapping of the protocol
+// buffers schema in proto/check/v1. The validated values are not included
+// in the report and the parameters of the violations are converted to
+// numbers, strings, booleans, nulls or lists of them (see NewWireReport).
+type WireReport struct {
+	Version    int             `json:"version"`
+	Valid      bool            `json:"valid"`
+	Violations []WireViolation `json:"violations,omitempty"`
+}
+
+// NewWireReport converts the error returned by a validation run, along with
+// the specified warnings, to a validation report. Errors of type Errors
+// produce a violation for each of the contained errors, with the severity
+// of the errors (see Warn). The report is valid if it does not contain
+// violations with the error severity. Numeric parameters are converted to
+// float64, times to RFC 3339 strings and other values which are not
+// booleans, strings or slices to their string representation. Non-finite
+// numbers (e.g. NaN) are left out.
+func NewWireReport(err error, warnings ...Warning) *WireReport {
+	r := &WireReport{Version: WireVersion, Valid: true}
+	if err != nil {
+		for _, err := range flattenErrors(err) {
+			e := toError(err)
+			r.Violations = append(r.Violations, WireViolation{
+				Field:    e.Field,
+				Code:     e.Code,
+				Message:  e.Message,
+				Params:   wireParams(e.Params),
+				Severity: e.severity(),
+			})
+			if e.severity() == SeverityError {
+				r.Valid = false
+			}
+		}
+	}
+
+	for _, w := range warnings {
+		r.Violations = append(r.Violations, WireViolation{
+			Field:    w.Field,
+			Code:     CodeInvalid,
+			Message:  w.Message,
+			Params:   map[string]interface{}{"rules": w.Rules},
+			Severity: SeverityWarning,
+		})
+	}
+
+	return r
+}
+
+// wireParams converts the parameters of a violation to values which can be
+// represented by the google.protobuf.Struct message.
+func wireParams(params map[string]interface{}) map[string]interface{} {
+	if len(params) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]interface{}, len(params))
+	for name, param := range params {
+		if v, ok := wireValue(reflect.ValueOf(param)); ok {
+			converted[name] = v
+		}
+	}
+
+	return converted
+}
+
+func wireValue(v reflect.Value) (interface{}, bool) {
+	if !v.IsValid() {
+		return nil, true
+	}
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, true
+		}
+	}
+
+	switch x := v.Interface().(type) {
+	case time.Time:
+		return x.Format(time.RFC3339Nano), true
+	case fmt.Stringer:
+		return x.String(), true
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return wireValue(v.Elem())
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		return f, true
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, true
+		}
+
+		list := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if elem, ok := wireValue(v.Index(i)); ok {
+				list = append(list, elem)
+			}
+		}
+		return list, true
+	}
+
+	return fmt.Sprint(v.Interface()), true
+}
+
+// ParseWireReport decodes the JSON encoding of a validation report. An
+// error is returned if the version of the report is not supported.
+func ParseWireReport(data []byte) (*WireReport, error) {
+	var r WireReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	if r.Version < 1 || r.Version > WireVersion {
+		return nil, errors.New("check: unsupported validation report version")
+	}
+
+	return &r, nil
+}
+
+// Err converts the violations of the report with the error severity back
+// to an error. It returns nil if the report does not contain errors, or
+// an error of type Errors otherwise. The returned errors are of type *Error.
+func (r *WireReport) Err() error {
+	var errs Errors
+	for _, v := range r.Violations {
+		if v.Severity != SeverityError {
+			continue
+		}
+
+		errs = append(errs, &Error{
+			Code:    v.Code,
+			Field:   v.Field,
+			Params:  v.Params,
+			Message: v.Message,
+		})
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}